@@ -0,0 +1,473 @@
+// cleaner-sim runs replications of cleaner's simulation on a virtual clock and writes CSV and JSON reports.
+// With -sweep it runs the scenario over a grid of one or two parameters for capacity planning
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/gantt"
+	"github.com/Bazhenator/cleaner/internal/simulation"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("simulation failed: %v", err)
+	}
+}
+
+func run() error {
+	fs := flag.NewFlagSet("cleaner-sim", flag.ExitOnError)
+	scenarioPath := fs.String("scenario", "", "scenario JSON file, flags below override its values")
+	out := fs.String("out", "sim-out", "directory for reports")
+	teams := fs.Uint64("teams", 10, "amount of teams")
+	speeds := fs.String("speeds", "", "comma-separated teams' speeds from 1 (fast) to 3 (slow), random if empty")
+	skills := fs.String("skills", "", `teams' skills, e.g. "0:2,1;1:0.5" (see TEAMS_SKILLS), teams clean any type if empty`)
+	capacities := fs.String("capacities", "", "comma-separated amounts of requests teams clean at once, one if empty")
+	baseSpeed := fs.Uint64("base-speed", 60, "mean cleaning time of slow team, seconds")
+	policy := fs.String("policy", configs.DefAssignPolicy, "team-selection policy: first, random, fastest or least-busy")
+	balkThreshold := fs.Int64("balk-threshold", -1, "queue length arriving requests balk at, requests never balk if negative")
+	patience := fs.Duration("patience", 0, "mean patience of requests, requests are patient if zero")
+	mtbf := fs.Duration("mtbf", 0, "mean time between teams' failures, teams never fail if zero")
+	mttr := fs.Duration("mttr", 10*time.Minute, "mean time to repair a team")
+	breakdownPolicy := fs.String("breakdown-policy", configs.BreakdownRequeue, "what happens to interrupted cleaning: requeue or fail")
+	calendarPath := fs.String("calendar", "", "teams' working hours JSON file, teams work around the clock if empty")
+	rework := fs.Float64("rework", 0, "probability completed request fails inspection and is reworked, never if zero")
+	reworkBoost := fs.Uint("rework-boost", 0, "priority boost of reworked requests")
+	reworkSameTeam := fs.Bool("rework-same-team", false, "rework request by the team, which has failed inspection")
+	travelModel := fs.String("travel", "", "travel-time model: euclidean, manhattan or matrix, teams reach clients instantly if empty")
+	travelSpeed := fs.Float64("travel-speed", 1, "distance teams cover per second")
+	travelPolicy := fs.String("travel-policy", configs.TravelChain, "what teams do after cleaning: return to base or chain to the next job")
+	travelMatrix := fs.String("travel-matrix", "", "CSV file of distances between sites for matrix model")
+	bases := fs.String("bases", "", `teams' home bases, e.g. "0:0,10:5" or sites "0,3"`)
+	area := fs.Float64("area", 0, "side of square requests' locations are drawn from")
+	sites := fs.Uint64("sites", 0, "amount of distance matrix's sites requests' locations are drawn from")
+	pipelinesPath := fs.String("pipelines", "", "cleaning pipelines JSON file, requests are cleaned in a single stage if empty")
+	fair := fs.Bool("fair", false, "serve requests of equal priority by weighted fair queueing across clients")
+	clientWeights := fs.String("client-weights", "", `clients' shares of teams under fair queueing, e.g. "7:2,12:0.5"`)
+	clientMaxWaiting := fs.Uint64("client-max-waiting", 0, "max amount of client's waiting requests, unlimited if zero")
+	clientRate := fs.Float64("client-rate", 0, "max rate of client's requests per second, unlimited if zero")
+	clientBurst := fs.Uint64("client-burst", 1, "amount of client's requests accepted at once over rate")
+	hourlyCosts := fs.String("hourly-costs", "", `costs of team's hour by speed, e.g. "1:40,2:30,3:20", teams' work is free if empty`)
+	prices := fs.String("prices", "", `prices of cleanings by cleaning type, e.g. "0:50,1:80"`)
+	surcharges := fs.String("surcharges", "", `shares of price added by priority, e.g. "2:0.25,3:0.5"`)
+	overtimeRate := fs.Float64("overtime-rate", 1, "multiplier of hourly cost in overtime")
+	subscriptionsPath := fs.String("subscriptions", "", "clients' recurring cleanings JSON file, none if empty")
+	ganttPath := fs.String("gantt", "", "Gantt chart file of the first replication's teams' activity: .csv, .json (trace events), .svg or .html")
+	eventsPath := fs.String("events", "", "JSON Lines event log of replications, e.g. events.jsonl makes events-0.jsonl for the first one, none if empty")
+	queueCapacity := fs.Int64("queue-capacity", -1, "max amount of waiting requests, 0 makes a loss system, unbounded if negative")
+	process := fs.String("process", configs.ProcessPoisson, "arrival process: poisson, deterministic, mmpp or trace")
+	rate := fs.Float64("rate", 0.1, "arrival rate, requests per second")
+	trace := fs.String("trace", "", "CSV trace or service's event log replayed by trace process")
+	priorities := fs.String("priorities", configs.DefGeneratorPriorities, "priority mix")
+	types := fs.String("types", configs.DefGeneratorTypes, "cleaning type mix")
+	horizon := fs.Duration("horizon", 0, "simulated time of each replication, warm-up included, trace's span or 24h if zero")
+	warmUp := fs.Duration("warm-up", time.Hour, "discarded warm-up period")
+	replications := fs.Int("replications", simulation.DefReplications, "amount of replications")
+	seed := fs.Uint64("seed", 1, "seed of the first replication")
+	confidence := fs.Float64("confidence", simulation.DefConfidence, "confidence level of intervals")
+	sweep := fs.String("sweep", "", `swept parameter (teams, base_speed, rate or queue_capacity), e.g. "teams=1:20" or "rate=0.01:0.1:0.01"`)
+	sweep2 := fs.String("sweep2", "", "the second swept parameter, makes a grid with -sweep")
+	_ = fs.Parse(os.Args[1:])
+
+	sc := &simulation.Scenario{
+		Service: &configs.Config{
+			Generator: &configs.GeneratorConfig{},
+		},
+	}
+	if *scenarioPath != "" {
+		loaded, err := simulation.LoadScenario(*scenarioPath)
+		if err != nil {
+			return err
+		}
+		sc = loaded
+	}
+	if sc.Service == nil {
+		sc.Service = &configs.Config{}
+	}
+	if sc.Service.Generator == nil {
+		sc.Service.Generator = &configs.GeneratorConfig{}
+	}
+
+	// Flags are applied when they are set explicitly or there is no scenario file
+	var applyErr error
+	var breakdownsSet, reworkSet, travelSet, clientsSet, costsSet bool
+	apply := func(f *flag.Flag) {
+		svc, gen := sc.Service, sc.Service.Generator
+		switch f.Name {
+		case "teams":
+			svc.TeamsAmount = *teams
+		case "speeds":
+			parsed, err := parseSpeeds(*speeds)
+			if err != nil {
+				applyErr = err
+			}
+			svc.TeamsSpeeds = parsed
+		case "skills":
+			parsed, err := configs.ParseSkills(*skills)
+			if err != nil {
+				applyErr = err
+			}
+			svc.TeamsSkills = parsed
+		case "capacities":
+			parsed, err := parseCapacities(*capacities)
+			if err != nil {
+				applyErr = err
+			}
+			svc.TeamsCapacities = parsed
+		case "base-speed":
+			svc.BaseSpeed = *baseSpeed
+		case "policy":
+			svc.AssignPolicy = *policy
+		case "queue-capacity":
+			svc.QueueCapacity = nil
+			if *queueCapacity >= 0 {
+				capacity := uint64(*queueCapacity)
+				svc.QueueCapacity = &capacity
+			}
+		case "mtbf", "mttr", "breakdown-policy":
+			breakdownsSet = true
+		case "rework", "rework-boost", "rework-same-team":
+			reworkSet = true
+		case "travel", "travel-speed", "travel-policy", "travel-matrix", "bases":
+			travelSet = true
+		case "fair", "client-weights", "client-max-waiting", "client-rate", "client-burst":
+			clientsSet = true
+		case "hourly-costs", "prices", "surcharges", "overtime-rate":
+			costsSet = true
+		case "area":
+			gen.Area = *area
+		case "sites":
+			gen.Sites = *sites
+		case "calendar":
+			svc.Calendar = nil
+			if *calendarPath != "" {
+				calendar, err := configs.LoadCalendar(*calendarPath)
+				if err != nil {
+					applyErr = err
+				}
+				svc.Calendar = calendar
+			}
+		case "pipelines":
+			svc.Pipelines = nil
+			if *pipelinesPath != "" {
+				pipelines, err := configs.LoadPipelines(*pipelinesPath)
+				if err != nil {
+					applyErr = err
+				}
+				svc.Pipelines = pipelines
+			}
+		case "subscriptions":
+			svc.Subscriptions = nil
+			if *subscriptionsPath != "" {
+				subscriptions, err := configs.LoadSubscriptions(*subscriptionsPath)
+				if err != nil {
+					applyErr = err
+				}
+				svc.Subscriptions = &configs.SubscriptionsConfig{Subscriptions: subscriptions}
+			}
+		case "events":
+			svc.Events = nil
+			if *eventsPath != "" {
+				svc.Events = &configs.EventsConfig{File: *eventsPath}
+			}
+		case "balk-threshold":
+			svc.BalkThreshold = nil
+			if *balkThreshold >= 0 {
+				threshold := uint64(*balkThreshold)
+				svc.BalkThreshold = &threshold
+			}
+		case "patience":
+			gen.MeanPatience = patience.Seconds()
+		case "process":
+			gen.Process = *process
+		case "rate":
+			gen.Rate = *rate
+		case "trace":
+			gen.TraceFile = *trace
+		case "priorities":
+			gen.Priorities = *priorities
+		case "types":
+			gen.Types = *types
+		case "horizon":
+			sc.Horizon = simulation.Duration(*horizon)
+		case "warm-up":
+			sc.WarmUp = simulation.Duration(*warmUp)
+		case "replications":
+			sc.Replications = *replications
+		case "seed":
+			sc.Seed = *seed
+		case "confidence":
+			sc.Confidence = *confidence
+		}
+	}
+	if *scenarioPath == "" {
+		fs.VisitAll(apply)
+	} else {
+		fs.Visit(apply)
+	}
+	if applyErr != nil {
+		return applyErr
+	}
+	if breakdownsSet {
+		sc.Service.Breakdowns = nil
+		if *mtbf > 0 {
+			sc.Service.Breakdowns = &configs.BreakdownsConfig{
+				Mtbf:   mtbf.Seconds(),
+				Mttr:   mttr.Seconds(),
+				Policy: *breakdownPolicy,
+			}
+		}
+	}
+	if travelSet {
+		sc.Service.Travel = nil
+		if *travelModel != "" {
+			parsed, err := configs.ParseLocations(*bases)
+			if err != nil {
+				return err
+			}
+			sc.Service.Travel = &configs.TravelConfig{
+				Model:      *travelModel,
+				Speed:      *travelSpeed,
+				MatrixFile: *travelMatrix,
+				Policy:     *travelPolicy,
+				Bases:      parsed,
+			}
+		}
+	}
+	if clientsSet {
+		sc.Service.Clients = nil
+		if *fair || *clientMaxWaiting > 0 || *clientRate > 0 {
+			weights, err := configs.ParseWeights(*clientWeights)
+			if err != nil {
+				return err
+			}
+			sc.Service.Clients = &configs.ClientsConfig{
+				FairQueueing: *fair,
+				Weights:      weights,
+				MaxWaiting:   *clientMaxWaiting,
+				Rate:         *clientRate,
+				Burst:        *clientBurst,
+			}
+		}
+	}
+	if costsSet {
+		sc.Service.Costs = nil
+		if *hourlyCosts != "" || *prices != "" {
+			hourly, err := configs.ParseRates(*hourlyCosts)
+			if err != nil {
+				return err
+			}
+			pricesByType, err := configs.ParseRates(*prices)
+			if err != nil {
+				return err
+			}
+			surchargesByPriority, err := configs.ParseRates(*surcharges)
+			if err != nil {
+				return err
+			}
+			sc.Service.Costs = &configs.CostsConfig{
+				HourlyCosts:  hourly,
+				Prices:       pricesByType,
+				Surcharges:   surchargesByPriority,
+				OvertimeRate: *overtimeRate,
+			}
+		}
+	}
+	if reworkSet {
+		sc.Service.Rework = nil
+		if *rework > 0 {
+			sc.Service.Rework = &configs.ReworkConfig{
+				Probability:   *rework,
+				PriorityBoost: *reworkBoost,
+				SameTeam:      *reworkSameTeam,
+			}
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if *sweep != "" {
+		return runSweep(ctx, sc, *out, *sweep, *sweep2)
+	}
+
+	var ganttFormat string
+	if *ganttPath != "" {
+		format, err := gantt.FormatOf(*ganttPath)
+		if err != nil {
+			return err
+		}
+		ganttFormat = format
+		sc.Timeline = true
+	}
+
+	started := time.Now()
+	report, err := simulation.Run(ctx, sc)
+	if err != nil {
+		return err
+	}
+
+	if err := writeReports(*out, report); err != nil {
+		return err
+	}
+	if *ganttPath != "" {
+		intervals := gantt.FromTimeline(report.Replications[0].Timeline)
+		err := writeFile(*ganttPath, func(w io.Writer) error {
+			return gantt.Write(w, ganttFormat, intervals)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("%d replications of %s (warm-up %s) done in %s, reports are in %s\n\n",
+		sc.Replications, time.Duration(sc.Horizon), time.Duration(sc.WarmUp), time.Since(started).Round(time.Millisecond), *out)
+
+	return printSummary(os.Stdout, report)
+}
+
+// runSweep runs scenario over grid of swept parameters and writes table, gnuplot and Vega-Lite reports
+func runSweep(ctx context.Context, sc *simulation.Scenario, dir, sweep, sweep2 string) error {
+	axes := make([]*simulation.Axis, 0, 2)
+	for _, def := range []string{sweep, sweep2} {
+		if def == "" {
+			continue
+		}
+
+		axis, err := simulation.ParseAxis(def)
+		if err != nil {
+			return err
+		}
+		axes = append(axes, axis)
+	}
+
+	started := time.Now()
+	report, err := simulation.Sweep(ctx, sc, axes, func(done, total int) {
+		fmt.Fprintf(os.Stderr, "\rpoint %d/%d", done, total)
+	})
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create reports directory: %w", err)
+	}
+
+	reports := []struct {
+		name  string
+		write func(io.Writer) error
+	}{
+		{name: "sweep.txt", write: report.WriteTable},
+		{name: "sweep.dat", write: report.WriteGnuplot},
+		{name: "sweep.vl.json", write: report.WriteVegaLite},
+	}
+	for _, r := range reports {
+		if err := writeFile(filepath.Join(dir, r.name), r.write); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("%d points of %d replications done in %s, reports are in %s\n\n",
+		len(report.Points), sc.Replications, time.Since(started).Round(time.Millisecond), dir)
+
+	return report.WriteTable(os.Stdout)
+}
+
+// writeReports writes summary, replications and JSON reports to dir
+func writeReports(dir string, report *simulation.Report) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create reports directory: %w", err)
+	}
+
+	reports := []struct {
+		name  string
+		write func(io.Writer) error
+	}{
+		{name: "summary.csv", write: report.WriteSummaryCSV},
+		{name: "replications.csv", write: report.WriteReplicationsCSV},
+		{name: "report.json", write: report.WriteJSON},
+	}
+
+	for _, r := range reports {
+		if err := writeFile(filepath.Join(dir, r.name), r.write); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return f.Close()
+}
+
+// printSummary prints system metrics with confidence intervals
+func printSummary(w io.Writer, report *simulation.Report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "METRIC\tMEAN\t±%g%% CI\n", report.Scenario.Confidence*100)
+	for _, m := range simulation.SystemMetrics {
+		e := report.System[m.Name]
+		fmt.Fprintf(tw, "%s\t%.4f\t%.4f\n", m.Name, e.Mean, e.HalfWidth)
+	}
+
+	return tw.Flush()
+}
+
+func parseSpeeds(list string) ([]uint64, error) {
+	var speeds []uint64
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		speed, err := strconv.ParseUint(s, 10, 64)
+		if err != nil || speed < 1 || speed > 3 {
+			return nil, fmt.Errorf("invalid team's speed %q", s)
+		}
+		speeds = append(speeds, speed)
+	}
+
+	return speeds, nil
+}
+
+func parseCapacities(list string) ([]uint64, error) {
+	var capacities []uint64
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		capacity, err := strconv.ParseUint(s, 10, 64)
+		if err != nil || capacity == 0 {
+			return nil, fmt.Errorf("invalid team's capacity %q", s)
+		}
+		capacities = append(capacities, capacity)
+	}
+
+	return capacities, nil
+}
@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -17,12 +19,17 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/clock"
+	"github.com/Bazhenator/cleaner/internal/dashboard"
 	"github.com/Bazhenator/cleaner/internal/delivery"
+	"github.com/Bazhenator/cleaner/internal/generator"
 	"github.com/Bazhenator/cleaner/internal/logic"
+	"github.com/Bazhenator/cleaner/internal/workload"
 
 	pb "github.com/Bazhenator/cleaner/pkg/api/grpc"
 	"github.com/Bazhenator/tools/src/logger"
@@ -61,6 +68,22 @@ func run() error {
 	grpcServer := newGrpcServer(config, l.Logger)
 	defer grpcServer.GracefulStop()
 
+	// Initializing cleaner's service
+	service, err := logic.NewService(config, l)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := service.Close(); err != nil {
+			l.Error(err.Error())
+		}
+	}()
+
+	// Initializing cleaner's health checking
+	healthServer := delivery.NewHealthServer(l, service)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	go healthServer.Run(ctx)
+
 	var c = make(chan os.Signal, 1)
 	defer signal.Stop(c)
 
@@ -71,19 +94,50 @@ func run() error {
 		switch s {
 		case syscall.SIGTERM, syscall.SIGINT:
 			l.InfoCtx(ctx, "graceful stop grpc server")
+			healthServer.Drain()
 			grpcServer.GracefulStop()
 		}
 	}()
 
 	reflection.Register(grpcServer)
 
-	// Initializing cleaner's service
-	service := logic.NewService(config, l)
-	
 	// Initializing cleaner's delivery
 	server := delivery.NewCleanerServer(config, l, service)
 	pb.RegisterCleanerServiceServer(grpcServer, server)
 
+	// Initializing cleaner's embedded arrivals generator
+	if config.Generator != nil {
+		source, err := workload.NewSource(config.Generator)
+		if err != nil {
+			return err
+		}
+
+		generator.NewGenerator(l, clock.NewReal(), source, service).Start(ctx)
+	}
+
+	// Initializing cleaner's HTTP/JSON gateway
+	if config.Http != nil {
+		gateway, err := delivery.NewGatewayServer(ctx, config, l)
+		if err != nil {
+			return err
+		}
+		if config.Http.Dashboard {
+			gateway.Handler = dashboard.Mount(gateway.Handler, l, service)
+		}
+		defer func() {
+			if err := gateway.Shutdown(context.Background()); err != nil {
+				l.Error("failed to stop gateway", logger.NewErrorField(err))
+			}
+		}()
+
+		go func() {
+			l.InfoCtx(ctx, "gateway has started", logger.NewField("addr", gateway.Addr))
+			if err := gateway.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				l.ErrorCtx(ctx, "gateway stopped with error", logger.NewErrorField(err))
+			}
+		}()
+	}
+
 	lis, deferGrpc, err := grpcListener.NewGrpcListener(config.Grpc)
 	if err != nil {
 		return err
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+
+	pb "github.com/Bazhenator/cleaner/pkg/api/grpc"
+)
+
+// clientView is a JSON representation of client's statistics
+type clientView struct {
+	ClientId         uint64  `json:"client_id"`
+	Weight           float64 `json:"weight"`
+	Arrivals         uint64  `json:"arrivals"`
+	Completed        uint64  `json:"completed"`
+	Rejected         uint64  `json:"rejected"`
+	Reneged          uint64  `json:"reneged"`
+	Waiting          uint64  `json:"waiting"`
+	MeanWaitTime     float64 `json:"mean_wait_time"`
+	MeanCleaningTime float64 `json:"mean_cleaning_time"`
+}
+
+func runClients(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("clients", flag.ExitOnError)
+	clientId := fs.Int64("client", -1, "show only this client, every known client if negative")
+	_ = fs.Parse(args)
+
+	in := &pb.GetClientStatsIn{}
+	if *clientId >= 0 {
+		id := uint64(*clientId)
+		in.ClientId = &id
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	out, err := cli.GetClientStats(callCtx, in)
+	if err != nil {
+		return err
+	}
+
+	views := make([]*clientView, 0, len(out.GetClients()))
+	rows := make([][]string, 0, len(out.GetClients()))
+	for _, c := range out.GetClients() {
+		v := &clientView{
+			ClientId:         c.GetClientId(),
+			Weight:           c.GetWeight(),
+			Arrivals:         c.GetArrivals(),
+			Completed:        c.GetCompleted(),
+			Rejected:         c.GetRejected(),
+			Reneged:          c.GetReneged(),
+			Waiting:          c.GetWaiting(),
+			MeanWaitTime:     c.GetMeanWaitTime(),
+			MeanCleaningTime: c.GetMeanCleaningTime(),
+		}
+		views = append(views, v)
+		rows = append(rows, []string{
+			strconv.FormatUint(v.ClientId, 10),
+			strconv.FormatFloat(v.Weight, 'g', -1, 64),
+			strconv.FormatUint(v.Arrivals, 10),
+			strconv.FormatUint(v.Completed, 10),
+			strconv.FormatUint(v.Rejected, 10),
+			strconv.FormatUint(v.Reneged, 10),
+			strconv.FormatUint(v.Waiting, 10),
+			formatSeconds(v.MeanWaitTime),
+			formatSeconds(v.MeanCleaningTime),
+		})
+	}
+
+	return cli.p.Table(
+		[]string{"CLIENT", "WEIGHT", "ARRIVALS", "COMPLETED", "REJECTED", "RENEGED", "WAITING", "MEAN WAIT", "MEAN CLEANING"},
+		rows,
+		map[string][]*clientView{"clients": views},
+	)
+}
@@ -0,0 +1,407 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/Bazhenator/cleaner/pkg/api/grpc"
+)
+
+// teamView is a JSON representation of team's statistics
+type teamView struct {
+	Id                uint64  `json:"id"`
+	Speed             uint32  `json:"speed"`
+	Available         bool    `json:"available"`
+	Capacity          uint64  `json:"capacity"`
+	FreeSlots         uint64  `json:"free_slots"`
+	ProcessedRequests uint64  `json:"processed_requests"`
+	TotalBusyTime     float64 `json:"total_busy_time"`
+	Failures          uint64  `json:"failures"`
+	Availability      float64 `json:"availability"`
+	Cost              float64 `json:"cost"`
+	Revenue           float64 `json:"revenue"`
+}
+
+// requestView is a JSON representation of processed request
+type requestView struct {
+	Id            uint64  `json:"id"`
+	ClientId      uint64  `json:"client_id"`
+	Priority      uint32  `json:"priority"`
+	CleaningType  uint32  `json:"cleaning_type"`
+	TeamId        uint64  `json:"team_id"`
+	TimeInCleaner float64 `json:"time_in_cleaner"`
+	Status        string  `json:"status"`
+	Cost          float64 `json:"cost,omitempty"`
+	Revenue       float64 `json:"revenue,omitempty"`
+
+	Stages []*stageView `json:"stages,omitempty"`
+}
+
+// stageView is a JSON representation of request's pipeline stage
+type stageView struct {
+	Name          string  `json:"name"`
+	TeamId        uint64  `json:"team_id"`
+	WaitTime      float64 `json:"wait_time"`
+	TimeInCleaner float64 `json:"time_in_cleaner"`
+}
+
+func runTeams(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("teams", flag.ExitOnError)
+	cleaningType := fs.Int("type", -1, "show only teams able to clean this type, all teams if negative")
+	_ = fs.Parse(args)
+
+	in := &pb.GetAvailableTeamsIn{}
+	if *cleaningType >= 0 {
+		t := uint32(*cleaningType)
+		in.CleaningType = &t
+	}
+
+	available, err := cli.availableTeams(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(available))
+	for _, id := range available {
+		rows = append(rows, []string{strconv.FormatUint(id, 10)})
+	}
+
+	return cli.p.Table([]string{"AVAILABLE TEAM"}, rows, map[string][]uint64{"teams_ids": available})
+}
+
+func runStats(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+	_ = fs.Parse(args)
+
+	return cli.printStats(ctx)
+}
+
+func runWatch(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	interval := fs.Duration("interval", 2*time.Second, "refresh interval")
+	_ = fs.Parse(args)
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		if _, ok := cli.p.(*tablePrinter); ok {
+			fmt.Printf("\n%s\n", time.Now().Format(time.DateTime))
+		}
+		if err := cli.printStats(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func runSubmit(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	id := fs.Uint64("id", uint64(time.Now().UnixNano()), "request id")
+	clientId := fs.Uint64("client", 0, "client id")
+	priority := fs.Uint("priority", 1, "request priority")
+	cleaningType := fs.Uint("type", 0, "cleaning type")
+	team := fs.Int64("team", -1, "team id, chosen by -policy if negative")
+	policyName := fs.String("policy", "first", "team-selection policy: first, random, fastest or least-busy")
+	queue := fs.Bool("queue", false, "put request to the service's queue instead of assigning it to a team")
+	patience := fs.Duration("patience", 0, "max time request waits in queue, unlimited if zero")
+	location := fs.String("location", "", `client's location, e.g. "10:5" or site "3", none if empty`)
+	_ = fs.Parse(args)
+
+	loc, err := parseLocation(*location)
+	if err != nil {
+		return err
+	}
+
+	if *queue {
+		req := &pb.Request{
+			Id:           *id,
+			ClientId:     *clientId,
+			Priority:     uint32(*priority),
+			CleaningType: uint32(*cleaningType),
+			Location:     loc,
+		}
+		if *patience > 0 {
+			seconds := patience.Seconds()
+			req.Patience = &seconds
+		}
+
+		return submitToQueue(ctx, cli, req)
+	}
+
+	teamId := uint64(*team)
+	if *team < 0 {
+		p, err := findPolicy(*policyName)
+		if err != nil {
+			return err
+		}
+
+		var ok bool
+		teamId, ok, err = cli.chooseTeam(ctx, p, rand.New(rand.NewPCG(*id, *clientId)), uint32(*cleaningType))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("no available teams")
+		}
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	out, err := cli.ProceedCleaning(callCtx, &pb.ProceedCleaningIn{
+		TeamId: teamId,
+		Req: &pb.Request{
+			Id:           *id,
+			ClientId:     *clientId,
+			Priority:     uint32(*priority),
+			CleaningType: uint32(*cleaningType),
+			Location:     loc,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req := newRequestView(out.GetReq())
+	return cli.p.Table(
+		[]string{"ID", "CLIENT", "PRIORITY", "TYPE", "TEAM", "TIME IN CLEANER"},
+		[][]string{{
+			strconv.FormatUint(req.Id, 10),
+			strconv.FormatUint(req.ClientId, 10),
+			strconv.FormatUint(uint64(req.Priority), 10),
+			strconv.FormatUint(uint64(req.CleaningType), 10),
+			strconv.FormatUint(req.TeamId, 10),
+			formatSeconds(req.TimeInCleaner),
+		}},
+		req,
+	)
+}
+
+func runRequest(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("request", flag.ExitOnError)
+	id := fs.Uint64("id", 0, "request id")
+	_ = fs.Parse(args)
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	out, err := cli.GetRequest(callCtx, &pb.GetRequestIn{Id: *id})
+	if err != nil {
+		return err
+	}
+
+	req := out.GetReq()
+	view := newRequestView(req)
+	return cli.p.Table(
+		[]string{"ID", "CLIENT", "PRIORITY", "TYPE", "STATUS", "TEAM", "WAIT TIME", "TIME IN CLEANER"},
+		[][]string{{
+			strconv.FormatUint(view.Id, 10),
+			strconv.FormatUint(view.ClientId, 10),
+			strconv.FormatUint(uint64(view.Priority), 10),
+			strconv.FormatUint(uint64(view.CleaningType), 10),
+			view.Status,
+			optionalUint(req.TeamId),
+			formatSeconds(req.GetWaitTime()),
+			formatSeconds(view.TimeInCleaner),
+		}},
+		view,
+	)
+}
+
+// submitToQueue puts request to the service's queue, so that service assigns it by its own policy
+func submitToQueue(ctx context.Context, cli *client, req *pb.Request) error {
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	out, err := cli.SubmitCleaning(callCtx, &pb.SubmitCleaningIn{Req: req})
+	if err != nil {
+		return err
+	}
+
+	view := newRequestView(out.GetReq())
+	return cli.p.Table(
+		[]string{"ID", "CLIENT", "PRIORITY", "TYPE", "STATUS"},
+		[][]string{{
+			strconv.FormatUint(view.Id, 10),
+			strconv.FormatUint(view.ClientId, 10),
+			strconv.FormatUint(uint64(view.Priority), 10),
+			strconv.FormatUint(uint64(view.CleaningType), 10),
+			view.Status,
+		}},
+		view,
+	)
+}
+
+// printStats prints every team's statistics along with its availability
+func (c *client) printStats(ctx context.Context) error {
+	teams, err := c.teamsStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	available, err := c.availableTeams(ctx, &pb.GetAvailableTeamsIn{})
+	if err != nil {
+		return err
+	}
+
+	isAvailable := make(map[uint64]bool, len(available))
+	for _, id := range available {
+		isAvailable[id] = true
+	}
+
+	views := make([]*teamView, 0, len(teams))
+	rows := make([][]string, 0, len(teams))
+	for _, t := range teams {
+		v := &teamView{
+			Id:                t.GetId(),
+			Speed:             t.GetSpeed(),
+			Available:         isAvailable[t.GetId()],
+			Capacity:          t.GetCapacity(),
+			FreeSlots:         t.GetFreeSlots(),
+			ProcessedRequests: t.GetProcessedRequests(),
+			TotalBusyTime:     t.GetTotalBusyTime(),
+			Failures:          t.GetFailures(),
+			Availability:      t.GetAvailability(),
+			Cost:              t.GetCost(),
+			Revenue:           t.GetRevenue(),
+		}
+		views = append(views, v)
+		rows = append(rows, []string{
+			strconv.FormatUint(v.Id, 10),
+			strconv.FormatUint(uint64(v.Speed), 10),
+			strconv.FormatBool(v.Available),
+			fmt.Sprintf("%d/%d", v.FreeSlots, v.Capacity),
+			strconv.FormatUint(v.ProcessedRequests, 10),
+			formatSeconds(v.TotalBusyTime),
+			strconv.FormatUint(v.Failures, 10),
+			strconv.FormatFloat(v.Availability, 'f', 3, 64),
+			strconv.FormatFloat(v.Cost, 'f', 2, 64),
+			strconv.FormatFloat(v.Revenue, 'f', 2, 64),
+		})
+	}
+
+	return c.p.Table([]string{"ID", "SPEED", "AVAILABLE", "FREE SLOTS", "PROCESSED", "BUSY TIME", "FAILURES", "AVAILABILITY", "COST", "REVENUE"}, rows, map[string][]*teamView{"teams": views})
+}
+
+func (c *client) availableTeams(ctx context.Context, in *pb.GetAvailableTeamsIn) ([]uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	out, err := c.GetAvailableTeams(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available teams: %w", err)
+	}
+
+	return out.GetTeamsIds(), nil
+}
+
+func (c *client) teamsStats(ctx context.Context) ([]*pb.Team, error) {
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	out, err := c.GetTeamsStats(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams stats: %w", err)
+	}
+
+	return out.GetTeams(), nil
+}
+
+// chooseTeam chooses available team able to clean type by policy. Returns false if there are no such teams
+func (c *client) chooseTeam(ctx context.Context, p *policy, rng *rand.Rand, cleaningType uint32) (uint64, bool, error) {
+	available, err := c.availableTeams(ctx, &pb.GetAvailableTeamsIn{CleaningType: &cleaningType})
+	if err != nil || len(available) == 0 {
+		return 0, false, err
+	}
+
+	var teams map[uint64]*pb.Team
+	if p.needsStats {
+		stats, err := c.teamsStats(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+
+		teams = make(map[uint64]*pb.Team, len(stats))
+		for _, t := range stats {
+			teams[t.GetId()] = t
+		}
+	}
+
+	return p.choose(rng, available, teams), true, nil
+}
+
+func newRequestView(req *pb.Request) *requestView {
+	var stages []*stageView
+	for _, st := range req.GetStages() {
+		stages = append(stages, &stageView{
+			Name:          st.GetName(),
+			TeamId:        st.GetTeamId(),
+			WaitTime:      st.GetWaitTime(),
+			TimeInCleaner: st.GetTimeInCleaner(),
+		})
+	}
+
+	return &requestView{
+		Id:            req.GetId(),
+		ClientId:      req.GetClientId(),
+		Priority:      req.GetPriority(),
+		CleaningType:  req.GetCleaningType(),
+		TeamId:        req.GetTeamId(),
+		TimeInCleaner: req.GetTimeInCleaner(),
+		Status:        strings.ToLower(strings.TrimPrefix(req.GetStatus().String(), "REQUEST_")),
+		Cost:          req.GetCost(),
+		Revenue:       req.GetRevenue(),
+		Stages:        stages,
+	}
+}
+
+// parseLocation parses point "x:y" or site of distance matrix. Returns nil if location is empty
+func parseLocation(s string) (*pb.Location, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	xStr, yStr, isPoint := strings.Cut(s, ":")
+	if !isPoint {
+		site, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid site %q: %w", s, err)
+		}
+		return &pb.Location{Site: site}, nil
+	}
+
+	x, errX := strconv.ParseFloat(xStr, 64)
+	y, errY := strconv.ParseFloat(yStr, 64)
+	if errX != nil || errY != nil {
+		return nil, fmt.Errorf("invalid point %q", s)
+	}
+
+	return &pb.Location{X: x, Y: y}, nil
+}
+
+// optionalUint formats optional value, absent one is shown as dash
+func optionalUint(value *uint64) string {
+	if value == nil {
+		return "-"
+	}
+
+	return strconv.FormatUint(*value, 10)
+}
+
+func formatSeconds(seconds float64) string {
+	return (time.Duration(seconds * float64(time.Second))).String()
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	pb "github.com/Bazhenator/cleaner/pkg/api/grpc"
+)
+
+// eventView is a JSON representation of service's event
+type eventView struct {
+	Seq            uint64       `json:"seq"`
+	Time           time.Time    `json:"time"`
+	Type           string       `json:"type"`
+	TeamId         *uint64      `json:"team_id,omitempty"`
+	TeamStatus     string       `json:"team_status,omitempty"`
+	AvailableTeams uint64       `json:"available_teams"`
+	Request        *requestView `json:"request,omitempty"`
+}
+
+// eventFormat is a line of events' table, events are printed as they come, so columns have fixed width
+const eventFormat = "%-8s  %-23s  %-20s  %-6s  %-14s  %-20s  %-12s\n"
+
+const eventTimeLayout = "2006-01-02 15:04:05.000"
+
+func runEvents(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("events", flag.ExitOnError)
+	offset := fs.Uint64("offset", 0, "sequence number of the first event, the earliest retained event if zero")
+	_ = fs.Parse(args)
+
+	stream, err := cli.StreamEvents(ctx, &pb.StreamEventsIn{Offset: *offset})
+	if err != nil {
+		return err
+	}
+
+	_, table := cli.p.(*tablePrinter)
+	if table {
+		fmt.Printf(eventFormat, "SEQ", "TIME", "TYPE", "TEAM", "TEAM STATUS", "REQUEST", "STATUS")
+	}
+	for {
+		e, err := stream.Recv()
+		if errors.Is(err, io.EOF) || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		v := &eventView{
+			Seq:            e.GetSeq(),
+			Time:           e.GetTime().AsTime(),
+			Type:           e.GetType(),
+			TeamId:         e.TeamId,
+			TeamStatus:     e.GetTeamStatus(),
+			AvailableTeams: e.GetAvailableTeams(),
+		}
+		if e.GetRequest() != nil {
+			v.Request = newRequestView(e.GetRequest())
+		}
+
+		if !table {
+			if err := cli.p.Table(nil, nil, v); err != nil {
+				return err
+			}
+			continue
+		}
+
+		team, request, status := "-", "-", "-"
+		if v.TeamId != nil {
+			team = strconv.FormatUint(*v.TeamId, 10)
+		}
+		if v.Request != nil {
+			request = strconv.FormatUint(v.Request.Id, 10)
+		}
+		if e.GetRequest().GetStatus() != pb.RequestStatus_REQUEST_STATUS_UNSPECIFIED {
+			status = v.Request.Status
+		}
+		fmt.Printf(eventFormat, strconv.FormatUint(v.Seq, 10), v.Time.Format(eventTimeLayout), v.Type,
+			team, orDash(v.TeamStatus), request, status)
+	}
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
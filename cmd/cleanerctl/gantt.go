@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Bazhenator/cleaner/internal/gantt"
+	pb "github.com/Bazhenator/cleaner/pkg/api/grpc"
+)
+
+func runGantt(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("gantt", flag.ExitOnError)
+	teamId := fs.Int64("team", -1, "show only this team, every team if negative")
+	last := fs.Duration("last", 0, "show only activity of this period before now, every retained interval if zero")
+	format := fs.String("format", "", "chart format: csv, trace, svg or html, defined by -file's extension if empty")
+	file := fs.String("file", "", "output file, standard output if empty")
+	_ = fs.Parse(args)
+
+	if *format == "" {
+		*format = gantt.FormatCSV
+		if *file != "" {
+			parsed, err := gantt.FormatOf(*file)
+			if err != nil {
+				return err
+			}
+			*format = parsed
+		}
+	}
+
+	in := &pb.GetTimelineIn{}
+	if *teamId >= 0 {
+		id := uint64(*teamId)
+		in.TeamId = &id
+	}
+	if *last > 0 {
+		in.From = timestamppb.New(time.Now().Add(-*last))
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	out, err := cli.GetTimeline(callCtx, in)
+	if err != nil {
+		return err
+	}
+
+	intervals := make([]gantt.Interval, 0, len(out.GetIntervals()))
+	for _, iv := range out.GetIntervals() {
+		intervals = append(intervals, gantt.Interval{
+			TeamId:    iv.GetTeamId(),
+			RequestId: iv.GetRequestId(),
+			Kind:      strings.ToLower(strings.TrimPrefix(iv.GetKind().String(), "ACTIVITY_")),
+			Start:     iv.GetStart().AsTime(),
+			End:       iv.GetEnd().AsTime(),
+		})
+	}
+
+	var w io.Writer = os.Stdout
+	if *file != "" {
+		f, err := os.Create(*file)
+		if err != nil {
+			return fmt.Errorf("failed to create chart file: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := gantt.Write(w, *format, intervals); err != nil {
+		return fmt.Errorf("failed to write chart: %w", err)
+	}
+
+	return nil
+}
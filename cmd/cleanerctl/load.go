@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Bazhenator/cleaner/internal/logic"
+	"github.com/Bazhenator/cleaner/internal/workload"
+	pb "github.com/Bazhenator/cleaner/pkg/api/grpc"
+)
+
+// loadReport is a summary of generated load
+type loadReport struct {
+	Policy        string            `json:"policy"`
+	Rate          float64           `json:"rate"`
+	Elapsed       float64           `json:"elapsed"`
+	Sent          uint64            `json:"sent"`
+	Assigned      uint64            `json:"assigned"`
+	Blocked       uint64            `json:"blocked"`
+	Failed        uint64            `json:"failed"`
+	AchievedRate  float64           `json:"achieved_rate"`
+	BlockingRatio float64           `json:"blocking_ratio"`
+	MeanCleaning  float64           `json:"mean_cleaning_time"`
+	PerTeam       map[uint64]uint64 `json:"per_team"`
+}
+
+func runLoad(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("load", flag.ExitOnError)
+	rate := fs.Float64("rate", 1, "mean arrival rate, requests per second")
+	duration := fs.Duration("duration", time.Minute, "how long to generate load")
+	count := fs.Uint64("count", 0, "stop after this many requests, 0 means no limit")
+	priorities := fs.String("priorities", "1", `priority mix, e.g. "1:0.2,2:0.5,3:0.3"`)
+	types := fs.String("types", "0", `cleaning type mix, e.g. "0:0.7,1:0.3"`)
+	clients := fs.Uint64("clients", 100, "amount of distinct client ids")
+	startId := fs.Uint64("start-id", uint64(time.Now().Unix())<<20, "id of the first request")
+	policyName := fs.String("policy", "random", "team-selection policy: first, random, fastest or least-busy")
+	seed := fs.Uint64("seed", uint64(time.Now().UnixNano()), "random seed")
+	queue := fs.Bool("queue", false, "put requests to the service's queue instead of assigning them to teams")
+	_ = fs.Parse(args)
+
+	if *rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	if *clients == 0 {
+		return errors.New("clients must be positive")
+	}
+
+	p, err := findPolicy(*policyName)
+	if err != nil {
+		return err
+	}
+	priorityMix, err := workload.ParseMix(*priorities)
+	if err != nil {
+		return fmt.Errorf("invalid priorities: %w", err)
+	}
+	typeMix, err := workload.ParseMix(*types)
+	if err != nil {
+		return fmt.Errorf("invalid types: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *duration)
+	defer cancel()
+
+	rng := rand.New(rand.NewPCG(*seed, *seed>>1))
+	g := &loadGenerator{
+		cli:    cli,
+		policy: p,
+		queue:  *queue,
+		report: &loadReport{
+			Policy:  p.name,
+			Rate:    *rate,
+			PerTeam: make(map[uint64]uint64),
+		},
+	}
+
+	started := time.Now()
+	timer := time.NewTimer(workload.Exponential(rng, *rate))
+	defer timer.Stop()
+
+	var wg sync.WaitGroup
+	for id := *startId; *count == 0 || id-*startId < *count; id++ {
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+			timer.Reset(workload.Exponential(rng, *rate))
+
+			req := &pb.Request{
+				Id:           id,
+				ClientId:     rng.Uint64N(*clients),
+				Priority:     uint32(priorityMix.Sample(rng)),
+				CleaningType: uint32(typeMix.Sample(rng)),
+			}
+			teamRng := rand.New(rand.NewPCG(rng.Uint64(), rng.Uint64()))
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				g.send(ctx, req, teamRng)
+			}()
+			continue
+		}
+		break
+	}
+	wg.Wait()
+
+	return g.print(time.Since(started))
+}
+
+// loadGenerator sends requests to cleaner and collects their outcomes
+type loadGenerator struct {
+	cli    *client
+	policy *policy
+	queue  bool // queue makes generator submit requests to the service's queue, blocked ones are rejected by its capacity
+
+	mu            sync.Mutex
+	report        *loadReport
+	totalCleaning float64
+}
+
+func (g *loadGenerator) send(ctx context.Context, req *pb.Request, rng *rand.Rand) {
+	if g.queue {
+		g.submit(ctx, req)
+		return
+	}
+
+	teamId, ok, err := g.cli.chooseTeam(ctx, g.policy, rng, req.GetCleaningType())
+	if err == nil && ok {
+		callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+		defer cancel()
+
+		var out *pb.ProceedCleaningOut
+		out, err = g.cli.ProceedCleaning(callCtx, &pb.ProceedCleaningIn{Req: req, TeamId: teamId})
+		if err == nil {
+			req = out.GetReq()
+		}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.report.Sent++
+	switch {
+	case isBlocked(err):
+		g.report.Blocked++
+	case err != nil:
+		g.report.Failed++
+	case !ok:
+		g.report.Blocked++
+	default:
+		g.report.Assigned++
+		g.report.PerTeam[teamId]++
+		g.totalCleaning += req.GetTimeInCleaner()
+	}
+}
+
+// submit puts request to the service's queue
+func (g *loadGenerator) submit(ctx context.Context, req *pb.Request) {
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	_, err := g.cli.SubmitCleaning(callCtx, &pb.SubmitCleaningIn{Req: req})
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.report.Sent++
+	switch {
+	case isBlocked(err):
+		g.report.Blocked++
+	case err != nil:
+		g.report.Failed++
+	default:
+		g.report.Assigned++
+	}
+}
+
+// notFree are errors of chosen team, which has stopped being free to take request before the call
+var notFree = []error{logic.ErrTeamBusy, logic.ErrTeamUnavailable, logic.ErrTeamOffShift}
+
+// isBlocked reports whether request is refused because of the service's load rather than failed:
+// chosen team is not free anymore, queue is full or client is rate limited
+func isBlocked(err error) bool {
+	switch status.Code(err) {
+	case codes.ResourceExhausted:
+		return true
+	case codes.FailedPrecondition:
+		// Code is shared with other preconditions, e.g. team's ineligibility, so team's state is told by message
+		msg := status.Convert(err).Message()
+		return slices.ContainsFunc(notFree, func(e error) bool { return strings.HasPrefix(msg, e.Error()) })
+	default:
+		return false
+	}
+}
+
+func (g *loadGenerator) print(elapsed time.Duration) error {
+	r := g.report
+	r.Elapsed = elapsed.Seconds()
+	if r.Elapsed > 0 {
+		r.AchievedRate = float64(r.Sent) / r.Elapsed
+	}
+	if r.Sent > 0 {
+		r.BlockingRatio = float64(r.Blocked) / float64(r.Sent)
+	}
+	if r.Assigned > 0 {
+		r.MeanCleaning = g.totalCleaning / float64(r.Assigned)
+	}
+
+	rows := [][]string{
+		{"policy", r.Policy},
+		{"offered rate", strconv.FormatFloat(r.Rate, 'f', 3, 64)},
+		{"achieved rate", strconv.FormatFloat(r.AchievedRate, 'f', 3, 64)},
+		{"elapsed", elapsed.Round(time.Millisecond).String()},
+		{"sent", strconv.FormatUint(r.Sent, 10)},
+		{"assigned", strconv.FormatUint(r.Assigned, 10)},
+		{"blocked", strconv.FormatUint(r.Blocked, 10)},
+		{"failed", strconv.FormatUint(r.Failed, 10)},
+		{"blocking ratio", strconv.FormatFloat(r.BlockingRatio, 'f', 4, 64)},
+		{"mean cleaning time", formatSeconds(r.MeanCleaning)},
+	}
+
+	teams := make([]uint64, 0, len(r.PerTeam))
+	for id := range r.PerTeam {
+		teams = append(teams, id)
+	}
+	slices.Sort(teams)
+	for _, id := range teams {
+		rows = append(rows, []string{fmt.Sprintf("team %d", id), strconv.FormatUint(r.PerTeam[id], 10)})
+	}
+
+	return g.cli.p.Table([]string{"METRIC", "VALUE"}, rows, r)
+}
@@ -0,0 +1,122 @@
+// cleanerctl is a command-line client for operating and load-testing cleaner service
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/Bazhenator/cleaner/pkg/api/grpc"
+)
+
+const usage = `Usage: cleanerctl [global flags] <command> [flags]
+
+Commands:
+  teams        list available teams
+  stats        show teams' statistics
+  clients      show clients' statistics
+  submit       send one cleaning request
+  request      show request's state
+  schedule     book cleaning starting inside time window
+  slots        list teams' free slots for cleaning type
+  subscribe    create client's recurring cleaning
+  subscription show, skip or reschedule subscription's occurrences
+  watch        periodically show teams' statistics
+  events       stream service's events
+  gantt        export teams' activity timeline as Gantt chart
+  load         generate Poisson load against cleaner
+
+Global flags:
+`
+
+// command is a cleanerctl subcommand
+type command struct {
+	name string
+	run  func(ctx context.Context, cli *client, args []string) error
+}
+
+var commands = []command{
+	{name: "teams", run: runTeams},
+	{name: "stats", run: runStats},
+	{name: "clients", run: runClients},
+	{name: "submit", run: runSubmit},
+	{name: "request", run: runRequest},
+	{name: "schedule", run: runSchedule},
+	{name: "slots", run: runSlots},
+	{name: "subscribe", run: runSubscribe},
+	{name: "subscription", run: runSubscription},
+	{name: "watch", run: runWatch},
+	{name: "events", run: runEvents},
+	{name: "gantt", run: runGantt},
+	{name: "load", run: runLoad},
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "cleanerctl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	fs := flag.NewFlagSet("cleanerctl", flag.ExitOnError)
+	addr := fs.String("addr", "localhost:50053", "cleaner's grpc address")
+	output := fs.String("o", "table", "output format: table or json")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(os.Args[1:])
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("command is not specified")
+	}
+
+	p, err := newPrinter(*output)
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to cleaner: %w", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	cli := &client{
+		CleanerServiceClient: pb.NewCleanerServiceClient(conn),
+		p:                    p,
+	}
+
+	name, args := fs.Arg(0), fs.Args()[1:]
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd.run(ctx, cli, args)
+		}
+	}
+
+	fs.Usage()
+	return fmt.Errorf("unknown command %q", name)
+}
+
+// client is a cleaner's grpc client with configured output
+type client struct {
+	pb.CleanerServiceClient
+
+	p printer
+}
+
+// callTimeout limits every single unary call to cleaner
+const callTimeout = 10 * time.Second
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// printer renders command results
+type printer interface {
+	// Table prints rows under header. Value is printed instead in JSON mode
+	Table(header []string, rows [][]string, value any) error
+}
+
+func newPrinter(format string) (printer, error) {
+	switch format {
+	case "table":
+		return &tablePrinter{w: os.Stdout}, nil
+	case "json":
+		return &jsonPrinter{enc: json.NewEncoder(os.Stdout)}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+type tablePrinter struct {
+	w io.Writer
+}
+
+func (p *tablePrinter) Table(header []string, rows [][]string, _ any) error {
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+type jsonPrinter struct {
+	enc *json.Encoder
+}
+
+func (p *jsonPrinter) Table(_ []string, _ [][]string, value any) error {
+	return p.enc.Encode(value)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	pb "github.com/Bazhenator/cleaner/pkg/api/grpc"
+)
+
+// policy chooses a team for request among available ones, the same way dispatcher does
+type policy struct {
+	name       string
+	needsStats bool // needsStats is true when policy relies on teams' statistics
+	choose     func(rng *rand.Rand, available []uint64, teams map[uint64]*pb.Team) uint64
+}
+
+var policies = []*policy{
+	{
+		name: "first",
+		choose: func(_ *rand.Rand, available []uint64, _ map[uint64]*pb.Team) uint64 {
+			return slices.Min(available)
+		},
+	},
+	{
+		name: "random",
+		choose: func(rng *rand.Rand, available []uint64, _ map[uint64]*pb.Team) uint64 {
+			return available[rng.IntN(len(available))]
+		},
+	},
+	{
+		name:       "fastest",
+		needsStats: true,
+		choose: func(_ *rand.Rand, available []uint64, teams map[uint64]*pb.Team) uint64 {
+			// The lower speed value is, the faster team cleans
+			return slices.MinFunc(available, func(a, b uint64) int {
+				return int(teams[a].GetSpeed()) - int(teams[b].GetSpeed())
+			})
+		},
+	},
+	{
+		name:       "least-busy",
+		needsStats: true,
+		choose: func(_ *rand.Rand, available []uint64, teams map[uint64]*pb.Team) uint64 {
+			return slices.MinFunc(available, func(a, b uint64) int {
+				switch ta, tb := teams[a].GetTotalBusyTime(), teams[b].GetTotalBusyTime(); {
+				case ta < tb:
+					return -1
+				case ta > tb:
+					return 1
+				default:
+					return 0
+				}
+			})
+		},
+	},
+}
+
+func findPolicy(name string) (*policy, error) {
+	for _, p := range policies {
+		if p.name == name {
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown team-selection policy %q", name)
+}
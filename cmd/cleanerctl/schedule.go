@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/Bazhenator/cleaner/pkg/api/grpc"
+)
+
+// slotView is a JSON representation of team's free slot
+type slotView struct {
+	TeamId   uint64    `json:"team_id"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Duration float64   `json:"duration"`
+}
+
+func runSchedule(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("schedule", flag.ExitOnError)
+	id := fs.Uint64("id", uint64(time.Now().UnixNano()), "request id")
+	clientId := fs.Uint64("client", 0, "client id")
+	priority := fs.Uint("priority", 1, "request priority")
+	cleaningType := fs.Uint("type", 0, "cleaning type")
+	location := fs.String("location", "", `client's location, e.g. "10:5" or site "3", none if empty`)
+	after := fs.Duration("after", 0, "earliest start of cleaning since now")
+	window := fs.Duration("window", time.Hour, "length of window cleaning may start in")
+	_ = fs.Parse(args)
+
+	loc, err := parseLocation(*location)
+	if err != nil {
+		return err
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	earliest := time.Now().Add(*after)
+	out, err := cli.ScheduleCleaning(callCtx, &pb.ScheduleCleaningIn{
+		Req: &pb.Request{
+			Id:           *id,
+			ClientId:     *clientId,
+			Priority:     uint32(*priority),
+			CleaningType: uint32(*cleaningType),
+			Location:     loc,
+		},
+		EarliestStart: timestamppb.New(earliest),
+		LatestStart:   timestamppb.New(earliest.Add(*window)),
+	})
+	if err != nil {
+		return err
+	}
+
+	req := out.GetReq()
+	view := newRequestView(req)
+	return cli.p.Table(
+		[]string{"ID", "CLIENT", "TYPE", "STATUS", "TEAM", "SCHEDULED AT"},
+		[][]string{{
+			strconv.FormatUint(view.Id, 10),
+			strconv.FormatUint(view.ClientId, 10),
+			strconv.FormatUint(uint64(view.CleaningType), 10),
+			view.Status,
+			optionalUint(req.TeamId),
+			req.GetScheduledAt().AsTime().Local().Format(time.DateTime),
+		}},
+		view,
+	)
+}
+
+func runSlots(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("slots", flag.ExitOnError)
+	cleaningType := fs.Uint("type", 0, "cleaning type")
+	horizon := fs.Duration("horizon", 24*time.Hour, "how far ahead of now to look for slots")
+	_ = fs.Parse(args)
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	now := time.Now()
+	out, err := cli.GetFreeSlots(callCtx, &pb.GetFreeSlotsIn{
+		CleaningType: uint32(*cleaningType),
+		From:         timestamppb.New(now),
+		To:           timestamppb.New(now.Add(*horizon)),
+	})
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(out.GetSlots()))
+	views := make([]*slotView, 0, len(out.GetSlots()))
+	for _, slot := range out.GetSlots() {
+		view := &slotView{
+			TeamId:   slot.GetTeamId(),
+			Start:    slot.GetStart().AsTime().Local(),
+			End:      slot.GetEnd().AsTime().Local(),
+			Duration: slot.GetDuration(),
+		}
+		views = append(views, view)
+		rows = append(rows, []string{
+			strconv.FormatUint(view.TeamId, 10),
+			view.Start.Format(time.DateTime),
+			view.End.Format(time.DateTime),
+			formatSeconds(view.Duration),
+		})
+	}
+
+	return cli.p.Table([]string{"TEAM", "FROM", "TILL", "CLEANING TIME"}, rows, views)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/Bazhenator/cleaner/pkg/api/grpc"
+)
+
+// occurrenceView is a JSON representation of subscription's occurrence
+type occurrenceView struct {
+	Number  uint64       `json:"number"`
+	Start   time.Time    `json:"start"`
+	Skipped bool         `json:"skipped"`
+	Request *requestView `json:"request,omitempty"`
+}
+
+func runSubscribe(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("subscribe", flag.ExitOnError)
+	id := fs.Uint64("id", uint64(time.Now().Unix()), "subscription id")
+	clientId := fs.Uint64("client", 0, "client id")
+	priority := fs.Uint("priority", 1, "occurrences' priority")
+	cleaningType := fs.Uint("type", 0, "cleaning type")
+	rule := fs.String("rule", "FREQ=WEEKLY", `recurrence, e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=10"`)
+	after := fs.Duration("after", 0, "the first occurrence's start since now")
+	window := fs.Duration("window", time.Hour, "how late occurrence's cleaning may start")
+	location := fs.String("location", "", `client's location, e.g. "10:5" or site "3", none if empty`)
+	sameTeam := fs.Bool("same-team", false, "prefer the team of the previous occurrence")
+	_ = fs.Parse(args)
+
+	loc, err := parseLocation(*location)
+	if err != nil {
+		return err
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	out, err := cli.CreateSubscription(callCtx, &pb.CreateSubscriptionIn{Subscription: &pb.Subscription{
+		Id:           *id,
+		ClientId:     *clientId,
+		CleaningType: uint32(*cleaningType),
+		Priority:     uint32(*priority),
+		Rule:         *rule,
+		Start:        timestamppb.New(time.Now().Add(*after)),
+		Window:       window.Seconds(),
+		Location:     loc,
+		SameTeam:     *sameTeam,
+	}})
+	if err != nil {
+		return err
+	}
+
+	return cli.printOccurrences(out.GetSubscription().GetOccurrences())
+}
+
+func runSubscription(ctx context.Context, cli *client, args []string) error {
+	fs := flag.NewFlagSet("subscription", flag.ExitOnError)
+	id := fs.Uint64("id", 0, "subscription id")
+	skip := fs.Int64("skip", -1, "skip occurrence with this number")
+	reschedule := fs.Int64("reschedule", -1, "move occurrence with this number to -at")
+	at := fs.Duration("at", 0, "new start of rescheduled occurrence since now")
+	cancelSub := fs.Bool("cancel", false, "cancel subscription")
+	_ = fs.Parse(args)
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	var occurrences []*pb.Occurrence
+	switch {
+	case *skip >= 0:
+		out, err := cli.SkipOccurrence(callCtx, &pb.SkipOccurrenceIn{SubscriptionId: *id, Number: uint64(*skip)})
+		if err != nil {
+			return err
+		}
+		occurrences = []*pb.Occurrence{out.GetOccurrence()}
+	case *reschedule >= 0:
+		if *at <= 0 {
+			return errors.New("new start -at is not specified")
+		}
+		out, err := cli.RescheduleOccurrence(callCtx, &pb.RescheduleOccurrenceIn{
+			SubscriptionId: *id,
+			Number:         uint64(*reschedule),
+			Start:          timestamppb.New(time.Now().Add(*at)),
+		})
+		if err != nil {
+			return err
+		}
+		occurrences = []*pb.Occurrence{out.GetOccurrence()}
+	case *cancelSub:
+		out, err := cli.CancelSubscription(callCtx, &pb.CancelSubscriptionIn{Id: *id})
+		if err != nil {
+			return err
+		}
+		occurrences = out.GetSubscription().GetOccurrences()
+	default:
+		out, err := cli.GetSubscription(callCtx, &pb.GetSubscriptionIn{Id: *id})
+		if err != nil {
+			return err
+		}
+		occurrences = out.GetSubscription().GetOccurrences()
+	}
+
+	return cli.printOccurrences(occurrences)
+}
+
+// printOccurrences prints subscription's occurrences with their requests
+func (c *client) printOccurrences(occurrences []*pb.Occurrence) error {
+	rows := make([][]string, 0, len(occurrences))
+	views := make([]*occurrenceView, 0, len(occurrences))
+	for _, occ := range occurrences {
+		view := &occurrenceView{
+			Number:  occ.GetNumber(),
+			Start:   occ.GetStart().AsTime().Local(),
+			Skipped: occ.GetSkipped(),
+		}
+		row := []string{strconv.FormatUint(view.Number, 10), view.Start.Format(time.DateTime), "-", "-", "-"}
+		if req := occ.GetReq(); req != nil {
+			view.Request = newRequestView(req)
+			row[2] = strconv.FormatUint(view.Request.Id, 10)
+			row[3] = view.Request.Status
+			row[4] = optionalUint(req.TeamId)
+		}
+		if view.Skipped {
+			row[3] = "skipped"
+		}
+		views = append(views, view)
+		rows = append(rows, row)
+	}
+
+	return c.p.Table([]string{"OCCURRENCE", "START", "REQUEST", "STATUS", "TEAM"}, rows, views)
+}
@@ -1,9 +1,13 @@
 package configs
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/multierr"
 
@@ -12,18 +16,295 @@ import (
 )
 
 const (
-	EnvBaseSpeed   = "BASE_SPEED"
-	EnvTeamsAmount = "TEAMS_AMOUNT"
+	EnvBaseSpeed       = "BASE_SPEED"
+	EnvTeamsAmount     = "TEAMS_AMOUNT"
+	EnvTeamsSpeeds     = "TEAMS_SPEEDS"
+	EnvTeamsSkills     = "TEAMS_SKILLS"
+	EnvTeamsCapacities = "TEAMS_CAPACITIES"
+
+	EnvHttpHost      = "HTTP_HOST"
+	EnvHttpPort      = "HTTP_PORT"
+	EnvHttpDashboard = "HTTP_DASHBOARD"
+
+	EnvAssignPolicy = "ASSIGN_POLICY"
+	DefAssignPolicy = "first"
+
+	EnvQueueCapacity       = "QUEUE_CAPACITY"
+	EnvQueuePriorityLimits = "QUEUE_PRIORITY_LIMITS"
+	EnvBalkThreshold       = "BALK_THRESHOLD"
+
+	EnvGeneratorProcess         = "GENERATOR_PROCESS"
+	EnvGeneratorRate            = "GENERATOR_RATE"
+	EnvGeneratorMmppRates       = "GENERATOR_MMPP_RATES"
+	EnvGeneratorMmppSwitchRates = "GENERATOR_MMPP_SWITCH_RATES"
+	EnvGeneratorTraceFile       = "GENERATOR_TRACE_FILE"
+	EnvGeneratorPriorities      = "GENERATOR_PRIORITIES"
+	DefGeneratorPriorities      = "1"
+	EnvGeneratorTypes           = "GENERATOR_TYPES"
+	DefGeneratorTypes           = "0"
+	EnvGeneratorClients         = "GENERATOR_CLIENTS"
+	DefGeneratorClients         = 100
+	EnvGeneratorSeed            = "GENERATOR_SEED"
+	EnvGeneratorMeanPatience    = "GENERATOR_MEAN_PATIENCE"
+	EnvGeneratorArea            = "GENERATOR_AREA"
+	EnvGeneratorSites           = "GENERATOR_SITES"
+
+	EnvBreakdownMtbf                = "BREAKDOWN_MTBF"
+	EnvBreakdownMttr                = "BREAKDOWN_MTTR"
+	EnvBreakdownFailureDistribution = "BREAKDOWN_FAILURE_DISTRIBUTION"
+	EnvBreakdownRepairDistribution  = "BREAKDOWN_REPAIR_DISTRIBUTION"
+	EnvBreakdownPolicy              = "BREAKDOWN_POLICY"
+
+	EnvCalendarFile = "CALENDAR_FILE"
+
+	EnvPipelinesFile = "PIPELINES_FILE"
+
+	EnvReworkProbability   = "REWORK_PROBABILITY"
+	EnvReworkPriorityBoost = "REWORK_PRIORITY_BOOST"
+	EnvReworkSameTeam      = "REWORK_SAME_TEAM"
+	EnvReworkStage         = "REWORK_STAGE"
+	EnvReworkMaxIterations = "REWORK_MAX_ITERATIONS"
+
+	EnvTravelModel      = "TRAVEL_MODEL"
+	EnvTravelSpeed      = "TRAVEL_SPEED"
+	EnvTravelMatrixFile = "TRAVEL_MATRIX_FILE"
+	EnvTravelPolicy     = "TRAVEL_POLICY"
+	EnvTravelBases      = "TRAVEL_BASES"
+
+	EnvClientsFairQueueing = "CLIENTS_FAIR_QUEUEING"
+	EnvClientsWeights      = "CLIENTS_WEIGHTS"
+	EnvClientsMaxWaiting   = "CLIENTS_MAX_WAITING"
+	EnvClientsRate         = "CLIENTS_RATE"
+	EnvClientsBurst        = "CLIENTS_BURST"
+
+	EnvCostsHourly       = "COSTS_HOURLY"
+	EnvCostsPrices       = "COSTS_PRICES"
+	EnvCostsSurcharges   = "COSTS_SURCHARGES"
+	EnvCostsOvertimeRate = "COSTS_OVERTIME_RATE"
+
+	EnvEventsFile     = "EVENTS_FILE"
+	EnvEventsMaxSize  = "EVENTS_MAX_SIZE"
+	DefEventsMaxSize  = 64 << 20
+	EnvEventsMaxFiles = "EVENTS_MAX_FILES"
+	DefEventsMaxFiles = 5
+	EnvEventsRetained = "EVENTS_RETAINED"
+	DefEventsRetained = 1 << 16
+
+	EnvSubscriptionsFile    = "SUBSCRIPTIONS_FILE"
+	EnvSubscriptionsHorizon = "SUBSCRIPTIONS_HORIZON"
+	DefSubscriptionsHorizon = 24 * 60 * 60
+)
+
+// Arrival processes of embedded generator
+const (
+	ProcessPoisson       = "poisson"
+	ProcessDeterministic = "deterministic"
+	ProcessMmpp          = "mmpp"
+	ProcessTrace         = "trace"
+)
+
+// Distributions of random durations
+const (
+	DistributionExponential   = "exponential"
+	DistributionDeterministic = "deterministic"
+	DistributionUniform       = "uniform"
+)
+
+// Policies of handling cleaning interrupted by team's breakdown
+const (
+	BreakdownRequeue = "requeue" // BreakdownRequeue puts interrupted request back to the queue
+	BreakdownFail    = "fail"    // BreakdownFail finishes interrupted request as failed
+)
+
+// Policies of handling cleaning, which lasts after team's shift has ended
+const (
+	OvertimeFinish  = "finish"  // OvertimeFinish makes team finish cleaning in overtime
+	OvertimeHandoff = "handoff" // OvertimeHandoff puts unfinished request back to the queue for another team
 )
 
+// Travel-time models
+const (
+	TravelEuclidean = "euclidean" // TravelEuclidean measures straight-line distance between points
+	TravelManhattan = "manhattan" // TravelManhattan measures distance along grid of streets
+	TravelMatrix    = "matrix"    // TravelMatrix looks up distance between sites in matrix
+)
+
+// What teams do after cleaning
+const (
+	TravelReturn = "return" // TravelReturn team goes back to its base after every cleaning
+	TravelChain  = "chain"  // TravelChain team goes straight to the next job from the last one
+)
+
+// HttpConfig is a configuration of HTTP/JSON gateway
+type HttpConfig struct {
+	Host      string
+	Port      string
+	Dashboard bool // Dashboard enables web dashboard served by gateway at /dashboard/
+}
+
 // Config is a main configuration struct for application
+// Service's parameters are also read from JSON by simulation's scenarios
 type Config struct {
-	Environment  string
-	Grpc         *grpcListener.GrpcConfig
-	LoggerConfig *logger.LoggerConfig
+	Environment  string                   `json:"-"`
+	Grpc         *grpcListener.GrpcConfig `json:"-"`
+	LoggerConfig *logger.LoggerConfig     `json:"-"`
+	Http         *HttpConfig              `json:"-"` // Http is nil when gateway is disabled
+
+	BaseSpeed       uint64   `json:"base_speed"`
+	TeamsAmount     uint64   `json:"teams_amount"`
+	TeamsSpeeds     []uint64 `json:"teams_speeds,omitempty"`     // TeamsSpeeds are fixed teams' speeds, random ones are used if empty
+	TeamsSkills     []Skills `json:"teams_skills,omitempty"`     // TeamsSkills are teams' skills by team id, teams without skills clean any type
+	TeamsCapacities []uint64 `json:"teams_capacities,omitempty"` // TeamsCapacities are amounts of requests teams clean at once by team id, one by default
+	AssignPolicy    string   `json:"assign_policy"`
+
+	QueueCapacity  *uint64         `json:"queue_capacity,omitempty"`  // QueueCapacity is a max amount of waiting requests, queue is unbounded if nil
+	PriorityLimits map[uint]uint64 `json:"priority_limits,omitempty"` // PriorityLimits are max amounts of waiting requests of each priority
+	BalkThreshold  *uint64         `json:"balk_threshold,omitempty"`  // BalkThreshold is a queue length, arriving requests balk at
+	Clients        *ClientsConfig  `json:"clients,omitempty"`         // Clients is nil when clients are neither limited nor served fairly
+
+	Generator  *GeneratorConfig  `json:"generator,omitempty"`  // Generator is nil when embedded generator is disabled
+	Breakdowns *BreakdownsConfig `json:"breakdowns,omitempty"` // Breakdowns is nil when teams never fail
+	Calendar   *CalendarConfig   `json:"calendar,omitempty"`   // Calendar is nil when teams work around the clock
+	Pipelines  Pipelines         `json:"pipelines,omitempty"`  // Pipelines are nil when every request is cleaned in a single stage
+	Rework     *ReworkConfig     `json:"rework,omitempty"`     // Rework is nil when completed cleanings always pass inspection
+	Travel     *TravelConfig     `json:"travel,omitempty"`     // Travel is nil when teams reach clients instantly
+	Costs      *CostsConfig      `json:"costs,omitempty"`      // Costs is nil when teams' work and cleanings are not priced
+
+	Subscriptions *SubscriptionsConfig `json:"subscriptions,omitempty"` // Subscriptions are nil when there are no predefined subscriptions
+
+	Events *EventsConfig `json:"events,omitempty"` // Events is nil when default amount of events is retained in memory only
+}
+
+// GeneratorConfig is a configuration of embedded arrivals generator
+type GeneratorConfig struct {
+	Process         string    `json:"process"`
+	Rate            float64   `json:"rate,omitempty"`              // Rate is used by poisson and deterministic processes
+	MmppRates       []float64 `json:"mmpp_rates,omitempty"`        // MmppRates are arrival rates in each state of mmpp
+	MmppSwitchRates []float64 `json:"mmpp_switch_rates,omitempty"` // MmppSwitchRates are rates of leaving each state of mmpp
+	TraceFile       string    `json:"trace_file,omitempty"`
+	Priorities      string    `json:"priorities,omitempty"` // Priorities is a priority mix, e.g. "1:0.2,2:0.8"
+	Types           string    `json:"types,omitempty"`      // Types is a cleaning type mix, e.g. "0:0.5,1:0.5"
+	Clients         uint64    `json:"clients,omitempty"`
+	Seed            uint64    `json:"seed,omitempty"`
+	MeanPatience    float64   `json:"mean_patience,omitempty"` // MeanPatience is a mean of exponential requests' patience in seconds, requests are patient if zero
+	Area            float64   `json:"area,omitempty"`          // Area is a side of square requests' locations are uniformly drawn from
+	Sites           uint64    `json:"sites,omitempty"`         // Sites is an amount of distance matrix's sites requests' locations are drawn from
+}
+
+// Skills are cleaning types team is eligible for with speed multipliers,
+// e.g. multiplier 2 makes team clean the type twice as fast as its speed implies
+type Skills map[uint]float64
 
-	BaseSpeed   uint64
-	TeamsAmount uint64
+// BreakdownsConfig is a configuration of teams' failures and repairs. Every team fails and is repaired independently
+type BreakdownsConfig struct {
+	Mtbf                float64 `json:"mtbf"` // Mtbf is a mean time between failures in seconds
+	Mttr                float64 `json:"mttr"` // Mttr is a mean time to repair in seconds
+	FailureDistribution string  `json:"failure_distribution,omitempty"`
+	RepairDistribution  string  `json:"repair_distribution,omitempty"`
+	Policy              string  `json:"policy,omitempty"`
+}
+
+// CalendarConfig is a configuration of teams' working hours
+type CalendarConfig struct {
+	TimeZone  string                     `json:"time_zone,omitempty"` // TimeZone is an IANA time zone of schedules, UTC if empty
+	Overtime  string                     `json:"overtime,omitempty"`  // Overtime is a policy of cleaning unfinished at shift's end
+	Schedules map[string]*ScheduleConfig `json:"schedules"`
+	Teams     []string                   `json:"teams"` // Teams are schedules' names by team id, the last one is used by the rest teams
+}
+
+// ScheduleConfig is a weekly schedule of team
+type ScheduleConfig struct {
+	TimeZone string          `json:"time_zone,omitempty"` // TimeZone overrides calendar's time zone
+	Shifts   []*PeriodConfig `json:"shifts"`
+	Breaks   []*PeriodConfig `json:"breaks,omitempty"`
+	DaysOff  []string        `json:"days_off,omitempty"` // DaysOff are dates without shifts, e.g. "2024-01-07"
+}
+
+// PeriodConfig is a daily period of local time, e.g. from "08:00" till "17:00".
+// Period ending before its start lasts till the next day
+type PeriodConfig struct {
+	Days  []string `json:"days,omitempty"` // Days are weekdays of period, e.g. "mon", every day if empty
+	Start string   `json:"start"`
+	End   string   `json:"end"`
+}
+
+// TravelConfig is a configuration of teams' travel to clients' locations
+type TravelConfig struct {
+	Model      string     `json:"model"`
+	Speed      float64    `json:"speed,omitempty"`       // Speed is a distance teams cover per second, 1 if zero
+	MatrixFile string     `json:"matrix_file,omitempty"` // MatrixFile is a CSV file of distances between sites for matrix model
+	Policy     string     `json:"policy,omitempty"`      // Policy is what teams do after cleaning, chain by default
+	Bases      []Location `json:"bases,omitempty"`       // Bases are teams' home bases by team id, the last one is used by the rest teams
+}
+
+// Location is a point on plane or a site of distance matrix
+type Location struct {
+	X    float64 `json:"x,omitempty"`
+	Y    float64 `json:"y,omitempty"`
+	Site uint64  `json:"site,omitempty"` // Site is an index of location in distance matrix
+}
+
+// ReworkConfig is a configuration of quality inspection of completed requests. Failed requests are queued again for rework
+type ReworkConfig struct {
+	Probability   float64 `json:"probability"`              // Probability is a chance completed request fails inspection
+	PriorityBoost uint    `json:"priority_boost,omitempty"` // PriorityBoost is added to priority of reworked request
+	SameTeam      bool    `json:"same_team,omitempty"`      // SameTeam makes the team, which has failed inspection, rework request
+	Stage         string  `json:"stage,omitempty"`          // Stage is a pipeline stage rework starts from, the first one if empty
+	MaxIterations uint    `json:"max_iterations,omitempty"` // MaxIterations is a max amount of reworks of request, unlimited if zero
+}
+
+// Pipelines are stages of cleaning by cleaning type, types without pipeline are cleaned in a single stage
+type Pipelines map[uint][]*StageConfig
+
+// StageConfig is a stage of cleaning pipeline served by its own pool of teams
+type StageConfig struct {
+	Name   string   `json:"name"`
+	Teams  []uint64 `json:"teams,omitempty"`  // Teams are ids of stage's teams, every team able to clean the type serves it if empty
+	Weight float64  `json:"weight,omitempty"` // Weight is a share of type's cleaning time stage takes, 1 if zero
+}
+
+// ClientsConfig is a configuration of clients' quotas and fair sharing of teams
+type ClientsConfig struct {
+	FairQueueing bool               `json:"fair_queueing,omitempty"` // FairQueueing serves requests of equal priority by weighted fair queueing across clients
+	Weights      map[uint64]float64 `json:"weights,omitempty"`       // Weights are clients' shares of teams by client id, 1 by default
+	MaxWaiting   uint64             `json:"max_waiting,omitempty"`   // MaxWaiting is a max amount of client's waiting requests, unlimited if zero
+	Rate         float64            `json:"rate,omitempty"`          // Rate is a max rate of client's requests per second, unlimited if zero
+	Burst        uint64             `json:"burst,omitempty"`         // Burst is an amount of client's requests accepted at once over rate, 1 if zero
+}
+
+// CostsConfig is a cost model of teams' work and prices of cleanings
+type CostsConfig struct {
+	HourlyCosts  map[uint]float64 `json:"hourly_costs,omitempty"`  // HourlyCosts are costs of team's hour by speed from 1 (fast) to 3 (slow)
+	Prices       map[uint]float64 `json:"prices,omitempty"`        // Prices are prices of cleanings by cleaning type
+	Surcharges   map[uint]float64 `json:"surcharges,omitempty"`    // Surcharges are shares of price added by priority, e.g. 0.5 makes cleaning 1.5 times as expensive
+	OvertimeRate float64          `json:"overtime_rate,omitempty"` // OvertimeRate multiplies hourly cost in overtime, 1 if zero
+}
+
+// EventsConfig is a configuration of service's event log
+type EventsConfig struct {
+	File     string `json:"file,omitempty"`      // File is a JSON Lines file events are appended to, events are not written if empty
+	MaxSize  int64  `json:"max_size,omitempty"`  // MaxSize is a size in bytes file is rotated at, 64 MiB if zero
+	MaxFiles int    `json:"max_files,omitempty"` // MaxFiles is an amount of rotated files kept, the newest one is kept even if zero
+	Retained uint64 `json:"retained,omitempty"`  // Retained is an amount of the latest events kept in memory for streaming, 65536 if zero
+}
+
+// SubscriptionsConfig is a configuration of clients' recurring cleanings
+type SubscriptionsConfig struct {
+	Horizon       float64               `json:"horizon,omitempty"` // Horizon is how far ahead in seconds occurrences are booked, a day if zero
+	Subscriptions []*SubscriptionConfig `json:"subscriptions,omitempty"`
+}
+
+// SubscriptionConfig is a client's recurring cleaning created at service's start
+type SubscriptionConfig struct {
+	Id           uint64    `json:"id"`
+	ClientId     uint64    `json:"client_id"`
+	CleaningType uint      `json:"cleaning_type"`
+	Priority     uint      `json:"priority,omitempty"`
+	Rule         string    `json:"rule"`             // Rule is an RRULE-style recurrence, e.g. "FREQ=WEEKLY;BYDAY=MO,TH"
+	Start        time.Time `json:"start,omitempty"`  // Start is the first occurrence's start, service's start if zero
+	Window       float64   `json:"window,omitempty"` // Window is how late in seconds occurrence's cleaning may start
+	Location     *Location `json:"location,omitempty"`
+	SameTeam     bool      `json:"same_team,omitempty"` // SameTeam makes occurrences prefer the team of the previous one
 }
 
 // NewConfig returns application config instance
@@ -52,6 +333,89 @@ func NewConfig() (*Config, error) {
 	teamsAmount, err := strconv.Atoi(EnvTeamsAmountStr)
 	multierr.AppendInto(&errorBuilder, err)
 
+	httpConfig, err := newHttpConfig(grpcConfig)
+	multierr.AppendInto(&errorBuilder, err)
+
+	teamsSpeeds, err := parseUints(os.Getenv(EnvTeamsSpeeds))
+	multierr.AppendInto(&errorBuilder, err)
+	if len(teamsSpeeds) > teamsAmount {
+		multierr.AppendInto(&errorBuilder, errors.New("TEAMS_SPEEDS has more speeds than TEAMS_AMOUNT"))
+	}
+	for _, speed := range teamsSpeeds {
+		if speed < 1 || speed > 3 {
+			multierr.AppendInto(&errorBuilder, fmt.Errorf("team's speed must be from 1 (fast) to 3 (slow), got %d", speed))
+		}
+	}
+
+	teamsSkills, err := ParseSkills(os.Getenv(EnvTeamsSkills))
+	multierr.AppendInto(&errorBuilder, err)
+	if len(teamsSkills) > teamsAmount {
+		multierr.AppendInto(&errorBuilder, errors.New("TEAMS_SKILLS has more teams than TEAMS_AMOUNT"))
+	}
+
+	teamsCapacities, err := parseUints(os.Getenv(EnvTeamsCapacities))
+	multierr.AppendInto(&errorBuilder, err)
+	if len(teamsCapacities) > teamsAmount {
+		multierr.AppendInto(&errorBuilder, errors.New("TEAMS_CAPACITIES has more capacities than TEAMS_AMOUNT"))
+	}
+	for _, capacity := range teamsCapacities {
+		if capacity == 0 {
+			multierr.AppendInto(&errorBuilder, errors.New("team's capacity must be positive"))
+		}
+	}
+
+	assignPolicy, ok := os.LookupEnv(EnvAssignPolicy)
+	if !ok {
+		assignPolicy = DefAssignPolicy
+	}
+
+	var queueCapacity *uint64
+	if capacityStr, ok := os.LookupEnv(EnvQueueCapacity); ok {
+		capacity, err := strconv.ParseUint(capacityStr, 10, 64)
+		multierr.AppendInto(&errorBuilder, err)
+		queueCapacity = &capacity
+	}
+
+	priorityLimits, err := parseLimits(os.Getenv(EnvQueuePriorityLimits))
+	multierr.AppendInto(&errorBuilder, err)
+
+	var balkThreshold *uint64
+	if thresholdStr, ok := os.LookupEnv(EnvBalkThreshold); ok {
+		threshold, err := strconv.ParseUint(thresholdStr, 10, 64)
+		multierr.AppendInto(&errorBuilder, err)
+		balkThreshold = &threshold
+	}
+
+	clientsConfig, err := newClientsConfig()
+	multierr.AppendInto(&errorBuilder, err)
+
+	generatorConfig, err := newGeneratorConfig()
+	multierr.AppendInto(&errorBuilder, err)
+
+	breakdownsConfig, err := newBreakdownsConfig()
+	multierr.AppendInto(&errorBuilder, err)
+
+	calendarConfig, err := newCalendarConfig()
+	multierr.AppendInto(&errorBuilder, err)
+
+	pipelines, err := newPipelines()
+	multierr.AppendInto(&errorBuilder, err)
+
+	reworkConfig, err := newReworkConfig()
+	multierr.AppendInto(&errorBuilder, err)
+
+	travelConfig, err := newTravelConfig()
+	multierr.AppendInto(&errorBuilder, err)
+
+	costsConfig, err := newCostsConfig()
+	multierr.AppendInto(&errorBuilder, err)
+
+	subscriptionsConfig, err := newSubscriptionsConfig()
+	multierr.AppendInto(&errorBuilder, err)
+
+	eventsConfig, err := newEventsConfig()
+	multierr.AppendInto(&errorBuilder, err)
+
 	if errorBuilder != nil {
 		return nil, errorBuilder
 	}
@@ -59,10 +423,685 @@ func NewConfig() (*Config, error) {
 	glCfg := &Config{
 		Grpc:         grpcConfig,
 		LoggerConfig: loggerConfig,
+		Http:         httpConfig,
+
+		BaseSpeed:       uint64(baseSpeed),
+		TeamsAmount:     uint64(teamsAmount),
+		TeamsSpeeds:     teamsSpeeds,
+		TeamsSkills:     teamsSkills,
+		TeamsCapacities: teamsCapacities,
+		AssignPolicy:    assignPolicy,
+
+		QueueCapacity:  queueCapacity,
+		PriorityLimits: priorityLimits,
+		BalkThreshold:  balkThreshold,
+		Clients:        clientsConfig,
 
-		BaseSpeed:   uint64(baseSpeed),
-		TeamsAmount: uint64(teamsAmount),
+		Generator:  generatorConfig,
+		Breakdowns: breakdownsConfig,
+		Calendar:   calendarConfig,
+		Pipelines:  pipelines,
+		Rework:     reworkConfig,
+		Travel:     travelConfig,
+		Costs:      costsConfig,
+
+		Subscriptions: subscriptionsConfig,
+
+		Events: eventsConfig,
 	}
 
 	return glCfg, nil
 }
+
+// newHttpConfig reads gateway's configuration. Gateway is disabled if HTTP_PORT is not defined
+func newHttpConfig(grpcConfig *grpcListener.GrpcConfig) (*HttpConfig, error) {
+	httpPort, ok := os.LookupEnv(EnvHttpPort)
+	if !ok {
+		return nil, nil
+	}
+
+	if _, err := strconv.Atoi(httpPort); err != nil {
+		return nil, err
+	}
+
+	httpHost, ok := os.LookupEnv(EnvHttpHost)
+	if !ok && grpcConfig != nil {
+		httpHost = grpcConfig.Host
+	}
+
+	var dashboard bool
+	if dashboardStr, ok := os.LookupEnv(EnvHttpDashboard); ok {
+		var err error
+		if dashboard, err = strconv.ParseBool(dashboardStr); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvHttpDashboard, err)
+		}
+	}
+
+	return &HttpConfig{
+		Host:      httpHost,
+		Port:      httpPort,
+		Dashboard: dashboard,
+	}, nil
+}
+
+// newGeneratorConfig reads embedded generator's configuration. Generator is disabled if GENERATOR_PROCESS is not defined
+func newGeneratorConfig() (*GeneratorConfig, error) {
+	process, ok := os.LookupEnv(EnvGeneratorProcess)
+	if !ok {
+		return nil, nil
+	}
+
+	var errorBuilder error
+
+	c := &GeneratorConfig{
+		Process:    process,
+		TraceFile:  os.Getenv(EnvGeneratorTraceFile),
+		Priorities: lookupString(EnvGeneratorPriorities, DefGeneratorPriorities),
+		Types:      lookupString(EnvGeneratorTypes, DefGeneratorTypes),
+		Clients:    DefGeneratorClients,
+		Seed:       uint64(time.Now().UnixNano()),
+	}
+
+	switch process {
+	case ProcessPoisson, ProcessDeterministic:
+		rate, err := strconv.ParseFloat(os.Getenv(EnvGeneratorRate), 64)
+		if err != nil || rate <= 0 {
+			multierr.AppendInto(&errorBuilder, errors.New("GENERATOR_RATE must be a positive number"))
+		}
+		c.Rate = rate
+	case ProcessMmpp:
+		rates, err := parseFloats(os.Getenv(EnvGeneratorMmppRates))
+		multierr.AppendInto(&errorBuilder, err)
+		switchRates, err := parseFloats(os.Getenv(EnvGeneratorMmppSwitchRates))
+		multierr.AppendInto(&errorBuilder, err)
+
+		c.MmppRates, c.MmppSwitchRates = rates, switchRates
+	case ProcessTrace:
+		if c.TraceFile == "" {
+			multierr.AppendInto(&errorBuilder, errors.New("GENERATOR_TRACE_FILE is not defined"))
+		}
+	default:
+		multierr.AppendInto(&errorBuilder, fmt.Errorf("unknown GENERATOR_PROCESS %q", process))
+	}
+
+	if clientsStr, ok := os.LookupEnv(EnvGeneratorClients); ok {
+		clients, err := strconv.ParseUint(clientsStr, 10, 64)
+		multierr.AppendInto(&errorBuilder, err)
+		c.Clients = clients
+	}
+
+	if patienceStr, ok := os.LookupEnv(EnvGeneratorMeanPatience); ok {
+		patience, err := strconv.ParseFloat(patienceStr, 64)
+		if err != nil || patience < 0 {
+			multierr.AppendInto(&errorBuilder, errors.New("GENERATOR_MEAN_PATIENCE must be a non-negative number"))
+		}
+		c.MeanPatience = patience
+	}
+
+	if areaStr, ok := os.LookupEnv(EnvGeneratorArea); ok {
+		area, err := strconv.ParseFloat(areaStr, 64)
+		if err != nil || area < 0 {
+			multierr.AppendInto(&errorBuilder, errors.New("GENERATOR_AREA must be a non-negative number"))
+		}
+		c.Area = area
+	}
+
+	if sitesStr, ok := os.LookupEnv(EnvGeneratorSites); ok {
+		sites, err := strconv.ParseUint(sitesStr, 10, 64)
+		multierr.AppendInto(&errorBuilder, err)
+		c.Sites = sites
+	}
+
+	if seedStr, ok := os.LookupEnv(EnvGeneratorSeed); ok {
+		seed, err := strconv.ParseUint(seedStr, 10, 64)
+		multierr.AppendInto(&errorBuilder, err)
+		c.Seed = seed
+	}
+
+	if errorBuilder != nil {
+		return nil, errorBuilder
+	}
+
+	return c, nil
+}
+
+// newBreakdownsConfig reads teams' breakdowns configuration. Teams never fail if BREAKDOWN_MTBF is not defined
+func newBreakdownsConfig() (*BreakdownsConfig, error) {
+	mtbfStr, ok := os.LookupEnv(EnvBreakdownMtbf)
+	if !ok {
+		return nil, nil
+	}
+
+	var errorBuilder error
+
+	mtbf, err := strconv.ParseFloat(mtbfStr, 64)
+	if err != nil || mtbf <= 0 {
+		multierr.AppendInto(&errorBuilder, errors.New("BREAKDOWN_MTBF must be a positive number"))
+	}
+
+	mttr, err := strconv.ParseFloat(os.Getenv(EnvBreakdownMttr), 64)
+	if err != nil || mttr <= 0 {
+		multierr.AppendInto(&errorBuilder, errors.New("BREAKDOWN_MTTR must be a positive number"))
+	}
+
+	c := &BreakdownsConfig{
+		Mtbf:                mtbf,
+		Mttr:                mttr,
+		FailureDistribution: lookupString(EnvBreakdownFailureDistribution, DistributionExponential),
+		RepairDistribution:  lookupString(EnvBreakdownRepairDistribution, DistributionExponential),
+		Policy:              lookupString(EnvBreakdownPolicy, BreakdownRequeue),
+	}
+
+	if c.Policy != BreakdownRequeue && c.Policy != BreakdownFail {
+		multierr.AppendInto(&errorBuilder, fmt.Errorf("unknown BREAKDOWN_POLICY %q", c.Policy))
+	}
+
+	if errorBuilder != nil {
+		return nil, errorBuilder
+	}
+
+	return c, nil
+}
+
+// newTravelConfig returns configuration of teams' travel. Teams reach clients instantly if TRAVEL_MODEL is not defined
+func newTravelConfig() (*TravelConfig, error) {
+	model, ok := os.LookupEnv(EnvTravelModel)
+	if !ok {
+		return nil, nil
+	}
+
+	var errorBuilder error
+
+	c := &TravelConfig{
+		Model:      model,
+		Speed:      1,
+		MatrixFile: os.Getenv(EnvTravelMatrixFile),
+		Policy:     lookupString(EnvTravelPolicy, TravelChain),
+	}
+
+	if speedStr, ok := os.LookupEnv(EnvTravelSpeed); ok {
+		speed, err := strconv.ParseFloat(speedStr, 64)
+		if err != nil || speed <= 0 {
+			multierr.AppendInto(&errorBuilder, errors.New("TRAVEL_SPEED must be a positive number"))
+		}
+		c.Speed = speed
+	}
+
+	bases, err := ParseLocations(os.Getenv(EnvTravelBases))
+	multierr.AppendInto(&errorBuilder, err)
+	c.Bases = bases
+
+	if c.Policy != TravelReturn && c.Policy != TravelChain {
+		multierr.AppendInto(&errorBuilder, fmt.Errorf("unknown TRAVEL_POLICY %q", c.Policy))
+	}
+
+	if errorBuilder != nil {
+		return nil, errorBuilder
+	}
+
+	return c, nil
+}
+
+// ParseLocations parses comma-separated list of locations, e.g. "0:0,10.5:3,7".
+// Location "x:y" is a point on plane, single number is a site of distance matrix
+func ParseLocations(list string) ([]Location, error) {
+	var locations []Location
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+
+		xStr, yStr, isPoint := strings.Cut(item, ":")
+		if !isPoint {
+			site, err := strconv.ParseUint(item, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid site %q: %w", item, err)
+			}
+			locations = append(locations, Location{Site: site})
+			continue
+		}
+
+		x, errX := strconv.ParseFloat(xStr, 64)
+		y, errY := strconv.ParseFloat(yStr, 64)
+		if errX != nil || errY != nil {
+			return nil, fmt.Errorf("invalid point %q", item)
+		}
+		locations = append(locations, Location{X: x, Y: y})
+	}
+
+	return locations, nil
+}
+
+// newReworkConfig returns configuration of quality inspection. Inspection always passes if REWORK_PROBABILITY is not defined
+func newReworkConfig() (*ReworkConfig, error) {
+	probabilityStr, ok := os.LookupEnv(EnvReworkProbability)
+	if !ok {
+		return nil, nil
+	}
+
+	var errorBuilder error
+
+	probability, err := strconv.ParseFloat(probabilityStr, 64)
+	if err != nil || probability < 0 || probability >= 1 {
+		multierr.AppendInto(&errorBuilder, errors.New("REWORK_PROBABILITY must be a number from 0 to 1 exclusive"))
+	}
+
+	c := &ReworkConfig{
+		Probability: probability,
+		Stage:       os.Getenv(EnvReworkStage),
+	}
+
+	if boostStr, ok := os.LookupEnv(EnvReworkPriorityBoost); ok {
+		boost, err := strconv.ParseUint(boostStr, 10, 64)
+		multierr.AppendInto(&errorBuilder, err)
+		c.PriorityBoost = uint(boost)
+	}
+	if sameTeamStr, ok := os.LookupEnv(EnvReworkSameTeam); ok {
+		c.SameTeam, err = strconv.ParseBool(sameTeamStr)
+		multierr.AppendInto(&errorBuilder, err)
+	}
+	if maxStr, ok := os.LookupEnv(EnvReworkMaxIterations); ok {
+		maxIterations, err := strconv.ParseUint(maxStr, 10, 64)
+		multierr.AppendInto(&errorBuilder, err)
+		c.MaxIterations = uint(maxIterations)
+	}
+
+	if errorBuilder != nil {
+		return nil, errorBuilder
+	}
+
+	return c, nil
+}
+
+// newCalendarConfig reads teams' working hours from JSON file. Teams work around the clock if CALENDAR_FILE is not defined
+func newCalendarConfig() (*CalendarConfig, error) {
+	path, ok := os.LookupEnv(EnvCalendarFile)
+	if !ok {
+		return nil, nil
+	}
+
+	return LoadCalendar(path)
+}
+
+// LoadCalendar reads teams' working hours from JSON file
+func LoadCalendar(path string) (*CalendarConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &CalendarConfig{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("invalid calendar %s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+// newPipelines reads cleaning pipelines from JSON file. Requests are cleaned in a single stage if PIPELINES_FILE is not defined
+func newPipelines() (Pipelines, error) {
+	path, ok := os.LookupEnv(EnvPipelinesFile)
+	if !ok {
+		return nil, nil
+	}
+
+	return LoadPipelines(path)
+}
+
+// LoadPipelines reads cleaning pipelines from JSON file
+func LoadPipelines(path string) (Pipelines, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var p Pipelines
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("invalid pipelines %s: %w", path, err)
+	}
+
+	return p, nil
+}
+
+// newClientsConfig returns configuration of clients' quotas and fair sharing. Returns nil if none of CLIENTS_* is defined
+func newClientsConfig() (*ClientsConfig, error) {
+	fairStr, hasFair := os.LookupEnv(EnvClientsFairQueueing)
+	weightsStr, hasWeights := os.LookupEnv(EnvClientsWeights)
+	maxWaitingStr, hasMaxWaiting := os.LookupEnv(EnvClientsMaxWaiting)
+	rateStr, hasRate := os.LookupEnv(EnvClientsRate)
+	burstStr, hasBurst := os.LookupEnv(EnvClientsBurst)
+	if !hasFair && !hasWeights && !hasMaxWaiting && !hasRate && !hasBurst {
+		return nil, nil
+	}
+
+	var errorBuilder error
+	var err error
+
+	c := &ClientsConfig{}
+	if hasFair {
+		c.FairQueueing, err = strconv.ParseBool(fairStr)
+		multierr.AppendInto(&errorBuilder, err)
+	}
+	c.Weights, err = ParseWeights(weightsStr)
+	multierr.AppendInto(&errorBuilder, err)
+	if hasMaxWaiting {
+		c.MaxWaiting, err = strconv.ParseUint(maxWaitingStr, 10, 64)
+		multierr.AppendInto(&errorBuilder, err)
+	}
+	if hasRate {
+		c.Rate, err = strconv.ParseFloat(rateStr, 64)
+		if err != nil || c.Rate < 0 {
+			multierr.AppendInto(&errorBuilder, errors.New("CLIENTS_RATE must be a non-negative number"))
+		}
+	}
+	if hasBurst {
+		c.Burst, err = strconv.ParseUint(burstStr, 10, 64)
+		multierr.AppendInto(&errorBuilder, err)
+	}
+
+	if errorBuilder != nil {
+		return nil, errorBuilder
+	}
+
+	return c, nil
+}
+
+// ParseWeights parses comma-separated clients' weights, e.g. "7:2,12:0.5"
+func ParseWeights(list string) (map[uint64]float64, error) {
+	var weights map[uint64]float64
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		clientStr, weightStr, ok := strings.Cut(s, ":")
+		if !ok {
+			return nil, fmt.Errorf("weight %q must look like client:weight", s)
+		}
+		client, err := strconv.ParseUint(strings.TrimSpace(clientStr), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(weightStr), 64)
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("weight of client %d must be a positive number", client)
+		}
+
+		if weights == nil {
+			weights = make(map[uint64]float64)
+		}
+		weights[client] = weight
+	}
+
+	return weights, nil
+}
+
+// newCostsConfig returns cost model of teams' work and cleanings. Returns nil if none of COSTS_* is defined
+func newCostsConfig() (*CostsConfig, error) {
+	hourlyStr, hasHourly := os.LookupEnv(EnvCostsHourly)
+	pricesStr, hasPrices := os.LookupEnv(EnvCostsPrices)
+	surchargesStr, hasSurcharges := os.LookupEnv(EnvCostsSurcharges)
+	overtimeRateStr, hasOvertimeRate := os.LookupEnv(EnvCostsOvertimeRate)
+	if !hasHourly && !hasPrices && !hasSurcharges && !hasOvertimeRate {
+		return nil, nil
+	}
+
+	var errorBuilder error
+	var err error
+
+	c := &CostsConfig{}
+	c.HourlyCosts, err = ParseRates(hourlyStr)
+	multierr.AppendInto(&errorBuilder, err)
+	for speed := range c.HourlyCosts {
+		if speed < 1 || speed > 3 {
+			multierr.AppendInto(&errorBuilder, fmt.Errorf("team's speed must be from 1 (fast) to 3 (slow), got %d", speed))
+		}
+	}
+	c.Prices, err = ParseRates(pricesStr)
+	multierr.AppendInto(&errorBuilder, err)
+	c.Surcharges, err = ParseRates(surchargesStr)
+	multierr.AppendInto(&errorBuilder, err)
+	if hasOvertimeRate {
+		c.OvertimeRate, err = strconv.ParseFloat(overtimeRateStr, 64)
+		if err != nil || c.OvertimeRate < 0 {
+			multierr.AppendInto(&errorBuilder, errors.New("COSTS_OVERTIME_RATE must be a non-negative number"))
+		}
+	}
+
+	if errorBuilder != nil {
+		return nil, errorBuilder
+	}
+
+	return c, nil
+}
+
+// ParseRates parses comma-separated key:amount pairs of non-negative amounts, e.g. "1:40,3:22.5"
+func ParseRates(list string) (map[uint]float64, error) {
+	var rates map[uint]float64
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		keyStr, amountStr, ok := strings.Cut(s, ":")
+		if !ok {
+			return nil, fmt.Errorf("rate %q must look like key:amount", s)
+		}
+		key, err := strconv.ParseUint(strings.TrimSpace(keyStr), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := strconv.ParseFloat(strings.TrimSpace(amountStr), 64)
+		if err != nil || amount < 0 {
+			return nil, fmt.Errorf("amount of %d must be a non-negative number", key)
+		}
+
+		if rates == nil {
+			rates = make(map[uint]float64)
+		}
+		rates[uint(key)] = amount
+	}
+
+	return rates, nil
+}
+
+// newSubscriptionsConfig reads predefined subscriptions from JSON file.
+// Returns nil if neither SUBSCRIPTIONS_FILE nor SUBSCRIPTIONS_HORIZON is defined
+func newSubscriptionsConfig() (*SubscriptionsConfig, error) {
+	path, hasFile := os.LookupEnv(EnvSubscriptionsFile)
+	horizonStr, hasHorizon := os.LookupEnv(EnvSubscriptionsHorizon)
+	if !hasFile && !hasHorizon {
+		return nil, nil
+	}
+
+	c := &SubscriptionsConfig{Horizon: DefSubscriptionsHorizon}
+	if hasHorizon {
+		horizon, err := strconv.ParseFloat(horizonStr, 64)
+		if err != nil || horizon <= 0 {
+			return nil, errors.New("SUBSCRIPTIONS_HORIZON must be a positive number")
+		}
+		c.Horizon = horizon
+	}
+	if hasFile {
+		subscriptions, err := LoadSubscriptions(path)
+		if err != nil {
+			return nil, err
+		}
+		c.Subscriptions = subscriptions
+	}
+
+	return c, nil
+}
+
+// LoadSubscriptions reads list of subscriptions from JSON file
+func LoadSubscriptions(path string) ([]*SubscriptionConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriptions []*SubscriptionConfig
+	if err := json.Unmarshal(data, &subscriptions); err != nil {
+		return nil, fmt.Errorf("invalid subscriptions %s: %w", path, err)
+	}
+
+	return subscriptions, nil
+}
+
+// newEventsConfig returns configuration of event log. Returns nil if none of EVENTS_* is defined
+func newEventsConfig() (*EventsConfig, error) {
+	path, hasFile := os.LookupEnv(EnvEventsFile)
+	maxSizeStr, hasMaxSize := os.LookupEnv(EnvEventsMaxSize)
+	maxFilesStr, hasMaxFiles := os.LookupEnv(EnvEventsMaxFiles)
+	retainedStr, hasRetained := os.LookupEnv(EnvEventsRetained)
+	if !hasFile && !hasMaxSize && !hasMaxFiles && !hasRetained {
+		return nil, nil
+	}
+
+	var errorBuilder error
+
+	c := &EventsConfig{
+		File:     path,
+		MaxSize:  DefEventsMaxSize,
+		MaxFiles: DefEventsMaxFiles,
+		Retained: DefEventsRetained,
+	}
+	if hasMaxSize {
+		maxSize, err := strconv.ParseInt(maxSizeStr, 10, 64)
+		if err != nil || maxSize <= 0 {
+			multierr.AppendInto(&errorBuilder, errors.New("EVENTS_MAX_SIZE must be a positive number"))
+		}
+		c.MaxSize = maxSize
+	}
+	if hasMaxFiles {
+		maxFiles, err := strconv.Atoi(maxFilesStr)
+		if err != nil || maxFiles < 0 {
+			multierr.AppendInto(&errorBuilder, errors.New("EVENTS_MAX_FILES must be a non-negative number"))
+		}
+		c.MaxFiles = maxFiles
+	}
+	if hasRetained {
+		retained, err := strconv.ParseUint(retainedStr, 10, 64)
+		multierr.AppendInto(&errorBuilder, err)
+		c.Retained = retained
+	}
+
+	if errorBuilder != nil {
+		return nil, errorBuilder
+	}
+
+	return c, nil
+}
+
+// lookupString returns env value or default one if env is not defined
+func lookupString(key, def string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+
+	return def
+}
+
+// parseFloats parses comma-separated list of floats
+func parseFloats(list string) ([]float64, error) {
+	var floats []float64
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		floats = append(floats, f)
+	}
+
+	return floats, nil
+}
+
+// parseUints parses comma-separated list of unsigned integers
+func parseUints(list string) ([]uint64, error) {
+	var uints []uint64
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		u, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		uints = append(uints, u)
+	}
+
+	return uints, nil
+}
+
+// parseLimits parses comma-separated list of priority:limit pairs, e.g. "1:10,2:5"
+func parseLimits(list string) (map[uint]uint64, error) {
+	var limits map[uint]uint64
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		priorityStr, limitStr, ok := strings.Cut(s, ":")
+		if !ok {
+			return nil, fmt.Errorf("limit %q must look like priority:limit", s)
+		}
+		priority, err := strconv.ParseUint(strings.TrimSpace(priorityStr), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		limit, err := strconv.ParseUint(strings.TrimSpace(limitStr), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		if limits == nil {
+			limits = make(map[uint]uint64)
+		}
+		limits[uint(priority)] = limit
+	}
+
+	return limits, nil
+}
+
+// ParseSkills parses semicolon-separated teams' skills, each one is a comma-separated list of type[:multiplier],
+// e.g. "0:2,1;1:0.5" makes team 0 clean type 0 twice as fast and type 1 as usual, team 1 cleans only type 1 twice as slow.
+// Team with empty list, e.g. the middle one of "0;;1", cleans any type
+func ParseSkills(list string) ([]Skills, error) {
+	if strings.TrimSpace(list) == "" {
+		return nil, nil
+	}
+
+	var teams []Skills
+	for _, team := range strings.Split(list, ";") {
+		if team = strings.TrimSpace(team); team == "" {
+			teams = append(teams, nil)
+			continue
+		}
+
+		skills := make(Skills)
+		for _, s := range strings.Split(team, ",") {
+			typeStr, multiplierStr, ok := strings.Cut(strings.TrimSpace(s), ":")
+			cleaningType, err := strconv.ParseUint(strings.TrimSpace(typeStr), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cleaning type in skill %q", s)
+			}
+
+			multiplier := 1.0
+			if ok {
+				multiplier, err = strconv.ParseFloat(strings.TrimSpace(multiplierStr), 64)
+				if err != nil || multiplier <= 0 {
+					return nil, fmt.Errorf("skill's multiplier must be a positive number, got %q", s)
+				}
+			}
+			skills[uint(cleaningType)] = multiplier
+		}
+		teams = append(teams, skills)
+	}
+
+	return teams, nil
+}
@@ -0,0 +1,37 @@
+package configs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSkills(t *testing.T) {
+	tests := []struct {
+		list  string
+		want  []Skills
+		valid bool
+	}{
+		{"", nil, true},
+		{"0", []Skills{{0: 1}}, true},
+		{"0:2,1;1:0.5", []Skills{{0: 2, 1: 1}, {1: 0.5}}, true},
+		{"0;;1", []Skills{{0: 1}, nil, {1: 1}}, true},
+		{";1", []Skills{nil, {1: 1}}, true},
+		{" 0 : 2 ; 1 ", []Skills{{0: 2}, {1: 1}}, true},
+		{"a", nil, false},
+		{"0:0", nil, false},
+		{"0:-1", nil, false},
+		{"0,", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.list, func(t *testing.T) {
+			got, err := ParseSkills(tt.list)
+			if (err == nil) != tt.valid {
+				t.Fatalf("ParseSkills(%q) error = %v, want valid = %t", tt.list, err, tt.valid)
+			}
+			if tt.valid && !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseSkills(%q) = %v, want %v", tt.list, got, tt.want)
+			}
+		})
+	}
+}
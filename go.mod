@@ -5,14 +5,16 @@ go 1.23.4
 require (
 	github.com/Bazhenator/tools v0.0.1
 	github.com/grpc-ecosystem/go-grpc-middleware v1.4.0
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.24.0
 	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.57.0
 	go.uber.org/multierr v1.6.0
 	go.uber.org/zap v1.18.1
+	google.golang.org/genproto/googleapis/api v0.0.0-20241206012308-a4fef0638583
 	google.golang.org/grpc v1.68.1
 	google.golang.org/protobuf v1.35.2
 )
 
-require google.golang.org/genproto v0.0.0-20241209162323-e6fa225c2576 // indirect
+require golang.org/x/tools v0.28.0 // indirect
 
 require (
 	github.com/go-logr/logr v1.4.2 // indirect
@@ -22,7 +24,6 @@ require (
 	go.opentelemetry.io/otel/metric v1.32.0 // indirect
 	go.opentelemetry.io/otel/trace v1.32.0 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
-	golang.org/x/exp v0.0.0-20241210172134-14434422244c
 	golang.org/x/net v0.32.0 // indirect
 	golang.org/x/sys v0.28.0 // indirect
 	golang.org/x/text v0.21.0 // indirect
@@ -0,0 +1,274 @@
+// Package calendar describes teams' working hours: weekly shifts, breaks and days off in a time zone
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+)
+
+// lookAhead is how many days are scanned for the next change of working state
+const lookAhead = 14
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// period is a daily period of local time
+type period struct {
+	days  map[time.Weekday]bool // days are weekdays of period, every day if empty
+	start time.Duration         // start is an offset from local midnight
+	end   time.Duration
+}
+
+// Interval is a period of absolute time [Start, End)
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Schedule is a weekly schedule of working hours
+type Schedule struct {
+	loc     *time.Location
+	shifts  []*period
+	breaks  []*period
+	daysOff map[string]bool
+}
+
+// NewSchedules creates schedule of every team from calendar's configuration
+func NewSchedules(c *configs.CalendarConfig, teams uint64) ([]*Schedule, error) {
+	if len(c.Teams) == 0 {
+		return nil, errors.New("calendar assigns no schedules to teams")
+	}
+	if c.Overtime != "" && c.Overtime != configs.OvertimeFinish && c.Overtime != configs.OvertimeHandoff {
+		return nil, fmt.Errorf("unknown overtime policy %q", c.Overtime)
+	}
+
+	byName := make(map[string]*Schedule, len(c.Schedules))
+	for name, sc := range c.Schedules {
+		tz := sc.TimeZone
+		if tz == "" {
+			tz = c.TimeZone
+		}
+
+		schedule, err := NewSchedule(sc, tz)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q: %w", name, err)
+		}
+		byName[name] = schedule
+	}
+
+	schedules := make([]*Schedule, 0, teams)
+	for i := uint64(0); i < teams; i++ {
+		name := c.Teams[min(i, uint64(len(c.Teams)-1))]
+		schedule, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("team %d has unknown schedule %q", i, name)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
+
+// NewSchedule creates schedule in given IANA time zone, UTC is used if it is empty
+func NewSchedule(c *configs.ScheduleConfig, timeZone string) (*Schedule, error) {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Shifts) == 0 {
+		return nil, errors.New("schedule has no shifts")
+	}
+
+	s := &Schedule{
+		loc:     loc,
+		daysOff: make(map[string]bool, len(c.DaysOff)),
+	}
+	if s.shifts, err = parsePeriods(c.Shifts); err != nil {
+		return nil, fmt.Errorf("invalid shift: %w", err)
+	}
+	if s.breaks, err = parsePeriods(c.Breaks); err != nil {
+		return nil, fmt.Errorf("invalid break: %w", err)
+	}
+	for _, day := range c.DaysOff {
+		if _, err := time.Parse(time.DateOnly, day); err != nil {
+			return nil, fmt.Errorf("invalid day off: %w", err)
+		}
+		s.daysOff[day] = true
+	}
+
+	return s, nil
+}
+
+// IsWorking reports whether moment t is inside a shift and outside breaks
+func (s *Schedule) IsWorking(t time.Time) bool {
+	for _, in := range s.intervals(t, 0) {
+		if !t.Before(in.Start) && t.Before(in.End) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextChange returns the first moment after t working state changes at.
+// Returns false if it does not change within two weeks
+func (s *Schedule) NextChange(t time.Time) (time.Time, bool) {
+	for _, in := range s.intervals(t, lookAhead) {
+		if t.Before(in.Start) {
+			return in.Start, true
+		}
+		if t.Before(in.End) {
+			return in.End, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// WorkingIntervals returns working intervals inside [from, to)
+func (s *Schedule) WorkingIntervals(from, to time.Time) []Interval {
+	var working []Interval
+	for _, in := range s.intervals(from, int(to.Sub(from).Hours()/24)+1) {
+		if !in.End.After(from) || !in.Start.Before(to) {
+			continue
+		}
+		if in.Start.Before(from) {
+			in.Start = from
+		}
+		if in.End.After(to) {
+			in.End = to
+		}
+		working = append(working, in)
+	}
+
+	return working
+}
+
+// intervals returns merged working intervals of shifts started from the day before t till days after it
+func (s *Schedule) intervals(t time.Time, days int) []Interval {
+	local := t.In(s.loc)
+
+	var working, breaks []Interval
+	for d := -1; d <= days+1; d++ {
+		date := time.Date(local.Year(), local.Month(), local.Day()+d, 0, 0, 0, 0, s.loc)
+		breaks = append(breaks, s.periodsOn(date, s.breaks)...)
+		if d <= days && !s.daysOff[date.Format(time.DateOnly)] {
+			working = append(working, s.periodsOn(date, s.shifts)...)
+		}
+	}
+
+	return subtract(merge(working), merge(breaks))
+}
+
+// periodsOn returns intervals of periods started on local date
+func (s *Schedule) periodsOn(date time.Time, periods []*period) []Interval {
+	var intervals []Interval
+	for _, p := range periods {
+		if len(p.days) > 0 && !p.days[date.Weekday()] {
+			continue
+		}
+
+		start := at(date, p.start)
+		end := at(date, p.end)
+		if !end.After(start) {
+			end = at(date.AddDate(0, 0, 1), p.end)
+		}
+		intervals = append(intervals, Interval{Start: start, End: end})
+	}
+
+	return intervals
+}
+
+// at returns moment of local time offset on date. Wall clock is used, so DST changes are respected
+func at(date time.Time, offset time.Duration) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, date.Location())
+}
+
+// merge sorts intervals and joins overlapping and adjacent ones
+func merge(intervals []Interval) []Interval {
+	slices.SortFunc(intervals, func(a, b Interval) int { return a.Start.Compare(b.Start) })
+
+	merged := make([]Interval, 0, len(intervals))
+	for _, in := range intervals {
+		if last := len(merged) - 1; last >= 0 && !in.Start.After(merged[last].End) {
+			if in.End.After(merged[last].End) {
+				merged[last].End = in.End
+			}
+			continue
+		}
+		merged = append(merged, in)
+	}
+
+	return merged
+}
+
+// subtract removes merged cuts from merged intervals
+func subtract(intervals, cuts []Interval) []Interval {
+	var out []Interval
+	for _, in := range intervals {
+		for _, cut := range cuts {
+			if !cut.End.After(in.Start) || !cut.Start.Before(in.End) {
+				continue
+			}
+			if cut.Start.After(in.Start) {
+				out = append(out, Interval{Start: in.Start, End: cut.Start})
+			}
+			in.Start = cut.End
+			if !in.Start.Before(in.End) {
+				break
+			}
+		}
+		if in.Start.Before(in.End) {
+			out = append(out, in)
+		}
+	}
+
+	return out
+}
+
+func parsePeriods(configs []*configs.PeriodConfig) ([]*period, error) {
+	periods := make([]*period, 0, len(configs))
+	for _, c := range configs {
+		p := &period{days: make(map[time.Weekday]bool, len(c.Days))}
+		for _, day := range c.Days {
+			weekday, ok := weekdays[strings.ToLower(day)[:min(3, len(day))]]
+			if !ok {
+				return nil, fmt.Errorf("unknown weekday %q", day)
+			}
+			p.days[weekday] = true
+		}
+
+		var err error
+		if p.start, err = parseClock(c.Start); err != nil {
+			return nil, err
+		}
+		if p.end, err = parseClock(c.End); err != nil {
+			return nil, err
+		}
+		periods = append(periods, p)
+	}
+
+	return periods, nil
+}
+
+// parseClock parses local time of day like "08:30"
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("time of day must look like 08:30, got %q", s)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
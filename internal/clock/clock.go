@@ -0,0 +1,34 @@
+// Package clock abstracts time, so cleaner can run both in real time and on a virtual clock of simulation
+package clock
+
+import (
+	"time"
+)
+
+// Clock tells current time and schedules functions in the future
+type Clock interface {
+	Now() time.Time
+	// AfterFunc calls f in its own goroutine (real clock) or in clock's loop (virtual clock) after duration d
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a scheduled call, which may be cancelled
+type Timer interface {
+	// Stop prevents the call. Returns false if the call has already happened or has been stopped
+	Stop() bool
+}
+
+// Real is a wall clock
+type Real struct{}
+
+func NewReal() Real {
+	return Real{}
+}
+
+func (Real) Now() time.Time {
+	return time.Now()
+}
+
+func (Real) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
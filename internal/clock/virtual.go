@@ -0,0 +1,140 @@
+package clock
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// Virtual is a discrete-event clock. Time jumps from one scheduled call to another, so hours of
+// simulated work take milliseconds. Calls are executed one by one in the goroutine running the clock
+type Virtual struct {
+	mu      sync.Mutex
+	now     time.Time
+	events  eventQueue
+	nextSeq uint64
+}
+
+func NewVirtual(start time.Time) *Virtual {
+	return &Virtual{now: start}
+}
+
+func (v *Virtual) Now() time.Time {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.now
+}
+
+func (v *Virtual) AfterFunc(d time.Duration, f func()) Timer {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	e := &event{at: v.now.Add(max(d, 0)), seq: v.nextSeq, f: f, clock: v, index: -1}
+	v.nextSeq++
+	heap.Push(&v.events, e)
+
+	return e
+}
+
+// RunUntil executes scheduled calls in time order until the next one is after deadline,
+// then moves clock to deadline. Calls may schedule new ones
+func (v *Virtual) RunUntil(deadline time.Time) {
+	for {
+		v.mu.Lock()
+		if len(v.events) == 0 || v.events[0].at.After(deadline) {
+			if v.now.Before(deadline) {
+				v.now = deadline
+			}
+			v.mu.Unlock()
+			return
+		}
+
+		e := heap.Pop(&v.events).(*event)
+		v.now = e.at
+		v.mu.Unlock()
+
+		e.f()
+	}
+}
+
+// Run executes scheduled calls until there are none left
+func (v *Virtual) Run() {
+	for {
+		v.mu.Lock()
+		if len(v.events) == 0 {
+			v.mu.Unlock()
+			return
+		}
+
+		e := heap.Pop(&v.events).(*event)
+		v.now = e.at
+		v.mu.Unlock()
+
+		e.f()
+	}
+}
+
+// Pending returns amount of scheduled calls
+func (v *Virtual) Pending() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return len(v.events)
+}
+
+// event is a call scheduled on virtual clock
+type event struct {
+	at    time.Time
+	seq   uint64 // seq keeps scheduling order of simultaneous calls
+	f     func()
+	clock *Virtual
+	index int // index is a position in clock's queue, -1 if event is not scheduled
+}
+
+func (e *event) Stop() bool {
+	e.clock.mu.Lock()
+	defer e.clock.mu.Unlock()
+
+	if e.index < 0 {
+		return false
+	}
+
+	heap.Remove(&e.clock.events, e.index)
+	return true
+}
+
+// eventQueue implements heap.Interface ordered by time
+type eventQueue []*event
+
+func (q eventQueue) Len() int { return len(q) }
+
+func (q eventQueue) Less(i, j int) bool {
+	if !q[i].at.Equal(q[j].at) {
+		return q[i].at.Before(q[j].at)
+	}
+
+	return q[i].seq < q[j].seq
+}
+
+func (q eventQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *eventQueue) Push(x any) {
+	e := x.(*event)
+	e.index = len(*q)
+	*q = append(*q, e)
+}
+
+func (q *eventQueue) Pop() any {
+	old := *q
+	e := old[len(old)-1]
+	old[len(old)-1] = nil
+	e.index = -1
+	*q = old[:len(old)-1]
+
+	return e
+}
@@ -0,0 +1,197 @@
+// Package dashboard serves embedded web dashboard of teams' state with live charts fed by service's events
+package dashboard
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/eventlog"
+	"github.com/Bazhenator/cleaner/internal/logic"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	"github.com/Bazhenator/tools/src/logger"
+)
+
+// Path is a path dashboard is served at
+const Path = "/dashboard/"
+
+// backlog is an amount of recent events sent to a new viewer, so charts are not empty from the start
+const backlog = 2000
+
+// keepAlive is a period of comments sent to idle events' stream, so proxies do not close it
+const keepAlive = 15 * time.Second
+
+//go:embed static
+var static embed.FS
+
+// handler serves dashboard's page, teams' and system's state and server-sent events
+type handler struct {
+	l       *logger.Logger
+	service logic.CleanerService
+}
+
+// Mount serves dashboard at Path and passes other requests to next
+func Mount(next http.Handler, l *logger.Logger, service logic.CleanerService) http.Handler {
+	h := &handler{l: l, service: service}
+	files, _ := fs.Sub(static, "static")
+
+	mux := http.NewServeMux()
+	mux.Handle("GET "+Path, http.StripPrefix(Path, http.FileServerFS(files)))
+	mux.HandleFunc("GET "+Path+"api/teams", h.teams)
+	mux.HandleFunc("GET "+Path+"api/stats", h.stats)
+	mux.HandleFunc("GET "+Path+"api/events", h.events)
+	mux.Handle("GET /dashboard", http.RedirectHandler(Path, http.StatusMovedPermanently))
+	mux.Handle("/", next)
+
+	return mux
+}
+
+// teamView is a team's state shown on dashboard
+type teamView struct {
+	Id                uint64   `json:"id"`
+	Speed             uint32   `json:"speed"`
+	Status            string   `json:"status"`
+	Capacity          uint64   `json:"capacity"`
+	ActiveRequests    []uint64 `json:"active_requests"`
+	ProcessedRequests uint64   `json:"processed_requests"`
+	Utilization       float64  `json:"utilization"`
+	OnShift           bool     `json:"on_shift"`
+}
+
+func (h *handler) teams(w http.ResponseWriter, r *http.Request) {
+	out, err := h.service.GetTeamsStats(r.Context())
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	views := make([]*teamView, 0, len(out.Stats))
+	for _, t := range out.Stats {
+		views = append(views, &teamView{
+			Id:                t.Id,
+			Speed:             t.Speed,
+			Status:            eventlog.TeamStatusName(uint(t.Status)),
+			Capacity:          t.Capacity,
+			ActiveRequests:    t.ActiveRequests,
+			ProcessedRequests: t.ProcessedRequests,
+			Utilization:       t.Utilization,
+			OnShift:           t.OnShift,
+		})
+	}
+
+	h.writeJSON(w, views)
+}
+
+// statsView is a system's state shown on dashboard, times are in seconds
+type statsView struct {
+	Arrivals         uint64  `json:"arrivals"`
+	Completed        uint64  `json:"completed"`
+	Rejected         uint64  `json:"rejected"`
+	Reneged          uint64  `json:"reneged"`
+	QueueLength      uint64  `json:"queue_length"`
+	MeanWaitTime     float64 `json:"mean_wait_time"`
+	MeanResponseTime float64 `json:"mean_response_time"`
+	Throughput       float64 `json:"throughput"`
+	Utilization      float64 `json:"utilization"`
+}
+
+func (h *handler) stats(w http.ResponseWriter, r *http.Request) {
+	out, err := h.service.GetSystemStats(r.Context())
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	h.writeJSON(w, &statsView{
+		Arrivals:         out.Arrivals,
+		Completed:        out.Completed,
+		Rejected:         out.Rejected,
+		Reneged:          out.Reneged,
+		QueueLength:      out.QueueLength,
+		MeanWaitTime:     out.MeanWaitTime.Seconds(),
+		MeanResponseTime: out.MeanResponseTime.Seconds(),
+		Throughput:       out.Throughput,
+		Utilization:      out.Utilization,
+	})
+}
+
+// events streams service's events as server-sent events with records of event log's schema.
+// Reconnecting browser resumes after the last received event
+func (h *handler) events(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	in := &dto.GetEventsIn{Wait: true, Tail: backlog}
+	if id := r.Header.Get("Last-Event-ID"); id != "" {
+		seq, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid Last-Event-ID", http.StatusBadRequest)
+			return
+		}
+		in.Offset = seq + 1
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ctx := r.Context()
+	for {
+		waitCtx, cancel := context.WithTimeout(ctx, keepAlive)
+		out, err := h.service.GetEvents(waitCtx, in)
+		cancel()
+
+		switch {
+		case ctx.Err() != nil:
+			return
+		case errors.Is(err, context.DeadlineExceeded):
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case errors.Is(err, logic.ErrEventsExpired):
+			// Viewer has fallen behind retained events, it catches up with recent ones
+			h.l.Warn("dashboard's events are lost", logger.NewErrorField(err))
+			in.Offset = 0
+			continue
+		case err != nil:
+			h.l.Error("failed to get events", logger.NewErrorField(err))
+			return
+		default:
+			for _, e := range out.Events {
+				data, err := json.Marshal(eventlog.NewRecord(e))
+				if err != nil {
+					h.l.Error("failed to encode event", logger.NewErrorField(err))
+					return
+				}
+				if _, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", e.Seq, data); err != nil {
+					return
+				}
+			}
+			in.Offset = out.Next
+		}
+
+		flusher.Flush()
+	}
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		h.l.Error("failed to write dashboard's data", logger.NewErrorField(err))
+	}
+}
+
+func (h *handler) fail(w http.ResponseWriter, err error) {
+	h.l.Error("failed to get dashboard's data", logger.NewErrorField(err))
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
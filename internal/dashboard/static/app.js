@@ -0,0 +1,244 @@
+// Dashboard polls teams' and system's state and draws charts of events streamed by the service.
+"use strict";
+
+const pollInterval = 2000;
+const bucketSize = 10 * 1000; // bucketSize is a width of chart's bar in milliseconds
+const bucketsShown = 60;
+const eventsShown = 100;
+
+// buckets are completions aggregated by bucket's start
+const buckets = new Map();
+let latest = 0; // latest is the latest event's time, charts end at it
+
+const teamsBody = document.getElementById("teams");
+const eventsList = document.getElementById("events");
+const connection = document.getElementById("connection");
+
+function seconds(value) {
+  if (value < 60) {
+    return value.toFixed(1) + "s";
+  }
+  if (value < 3600) {
+    return (value / 60).toFixed(1) + "m";
+  }
+  return (value / 3600).toFixed(1) + "h";
+}
+
+function percent(value) {
+  return (value * 100).toFixed(1) + "%";
+}
+
+function text(id, value) {
+  document.getElementById(id).textContent = value;
+}
+
+async function fetchJSON(path) {
+  const resp = await fetch(path);
+  if (!resp.ok) {
+    throw new Error(path + ": " + resp.status);
+  }
+  return resp.json();
+}
+
+async function refresh() {
+  try {
+    const [teams, stats] = await Promise.all([fetchJSON("api/teams"), fetchJSON("api/stats")]);
+    renderTeams(teams);
+    renderStats(stats);
+  } catch (err) {
+    console.error(err);
+  }
+}
+
+function renderTeams(teams) {
+  teamsBody.replaceChildren(...teams.map((team) => {
+    const tr = document.createElement("tr");
+    tr.dataset.team = team.id;
+
+    const status = document.createElement("span");
+    status.className = "status " + team.status;
+    status.textContent = team.status.replace("_", " ");
+
+    const bar = document.createElement("span");
+    bar.className = "bar";
+    bar.style.width = Math.round(team.utilization * 60) + "px";
+
+    const cells = [
+      String(team.id),
+      String(team.speed),
+      status,
+      (team.active_requests || []).join(", ") || "-",
+      String(team.processed_requests),
+      [bar, percent(team.utilization)],
+    ];
+    for (const cell of cells) {
+      const td = document.createElement("td");
+      td.append(...[].concat(cell));
+      tr.append(td);
+    }
+    return tr;
+  }));
+}
+
+function renderStats(stats) {
+  text("arrivals", stats.arrivals);
+  text("completed", stats.completed);
+  text("lost", stats.rejected + " / " + stats.reneged);
+  text("queue", stats.queue_length);
+  text("wait", seconds(stats.mean_wait_time));
+  text("response", seconds(stats.mean_response_time));
+  text("utilization", percent(stats.utilization));
+}
+
+// onEvent accounts event of event log's schema
+function onEvent(record) {
+  const time = Date.parse(record.time);
+  latest = Math.max(latest, time);
+
+  if (record.type === "team_status_changed") {
+    const status = teamsBody.querySelector(`tr[data-team="${record.team_id}"] .status`);
+    if (status) {
+      status.className = "status " + record.team_status;
+      status.textContent = record.team_status.replace("_", " ");
+    }
+  }
+
+  if (record.type === "request_completed" && record.request) {
+    const req = record.request;
+    const start = Math.floor(time / bucketSize) * bucketSize;
+    const bucket = buckets.get(start) || { completed: 0, response: 0, wait: 0 };
+    bucket.completed++;
+    bucket.response += (Date.parse(req.finished_at) - Date.parse(req.submitted_at)) / 1000;
+    bucket.wait += req.wait_time;
+    buckets.set(start, bucket);
+  }
+
+  const li = document.createElement("li");
+  const at = document.createElement("span");
+  at.className = "time";
+  at.textContent = new Date(time).toLocaleTimeString() + " ";
+  let line = "#" + record.seq + " " + record.type;
+  if (record.team_id !== undefined) {
+    line += " team " + record.team_id;
+  }
+  if (record.request) {
+    line += " request " + record.request.id;
+  }
+  li.append(at, line);
+  eventsList.prepend(li);
+  while (eventsList.childElementCount > eventsShown) {
+    eventsList.lastElementChild.remove();
+  }
+}
+
+// series returns charts' points of the latest buckets
+function series() {
+  const last = Math.floor(latest / bucketSize) * bucketSize;
+  const points = [];
+  for (let i = bucketsShown - 1; i >= 0; i--) {
+    const start = last - i * bucketSize;
+    const bucket = buckets.get(start);
+    points.push({
+      start: start,
+      throughput: bucket ? bucket.completed * 60000 / bucketSize : 0,
+      response: bucket ? bucket.response / bucket.completed : null,
+      wait: bucket ? bucket.wait / bucket.completed : null,
+    });
+  }
+  for (const start of buckets.keys()) {
+    if (start < last - bucketsShown * bucketSize) {
+      buckets.delete(start);
+    }
+  }
+  return points;
+}
+
+// chart draws lines or bars of points' values on canvas with value and time axes
+function chart(canvas, points, lines, bars) {
+  const ctx = canvas.getContext("2d");
+  const pad = { left: 44, right: 8, top: 8, bottom: 22 };
+  const w = canvas.width - pad.left - pad.right;
+  const h = canvas.height - pad.top - pad.bottom;
+
+  let top = 0;
+  for (const p of points) {
+    for (const key of lines) {
+      top = Math.max(top, p[key] || 0);
+    }
+  }
+  top = top > 0 ? top * 1.1 : 1;
+
+  ctx.clearRect(0, 0, canvas.width, canvas.height);
+  ctx.font = "11px sans-serif";
+  ctx.fillStyle = "#777";
+  ctx.strokeStyle = "#eee";
+  ctx.textAlign = "right";
+  for (let i = 0; i <= 4; i++) {
+    const y = pad.top + h - (h * i) / 4;
+    ctx.beginPath();
+    ctx.moveTo(pad.left, y);
+    ctx.lineTo(pad.left + w, y);
+    ctx.stroke();
+    ctx.fillText((top * i / 4).toFixed(1), pad.left - 4, y + 4);
+  }
+  ctx.textAlign = "center";
+  for (let i = 0; i < points.length; i += 15) {
+    const x = pad.left + (w * i) / points.length;
+    ctx.fillText(new Date(points[i].start).toLocaleTimeString(), x, canvas.height - 6);
+  }
+
+  const colors = ["#4c78a8", "#f58518"];
+  lines.forEach((key, n) => {
+    ctx.fillStyle = ctx.strokeStyle = colors[n];
+    ctx.lineWidth = 2;
+    const step = w / points.length;
+    if (bars) {
+      points.forEach((p, i) => {
+        const bh = (h * (p[key] || 0)) / top;
+        ctx.fillRect(pad.left + i * step + 1, pad.top + h - bh, step - 2, bh);
+      });
+      return;
+    }
+    ctx.beginPath();
+    let drawing = false;
+    points.forEach((p, i) => {
+      if (p[key] === null) {
+        drawing = false;
+        return;
+      }
+      const x = pad.left + i * step + step / 2;
+      const y = pad.top + h - (h * p[key]) / top;
+      if (drawing) {
+        ctx.lineTo(x, y);
+      } else {
+        ctx.moveTo(x, y);
+        drawing = true;
+      }
+    });
+    ctx.stroke();
+  });
+}
+
+function draw() {
+  const points = series();
+  chart(document.getElementById("throughput"), points, ["throughput"], true);
+  chart(document.getElementById("latency"), points, ["response", "wait"], false);
+}
+
+function connect() {
+  const source = new EventSource("api/events");
+  source.onopen = () => {
+    connection.className = "connection online";
+    connection.textContent = "live";
+  };
+  source.onerror = () => {
+    connection.className = "connection offline";
+    connection.textContent = "offline";
+  };
+  source.onmessage = (msg) => onEvent(JSON.parse(msg.data));
+}
+
+refresh();
+connect();
+setInterval(refresh, pollInterval);
+setInterval(draw, 1000);
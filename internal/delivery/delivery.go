@@ -2,10 +2,13 @@ package delivery
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/eventlog"
 	"github.com/Bazhenator/cleaner/internal/logic"
 	"github.com/Bazhenator/cleaner/internal/logic/dto"
 	cleaner "github.com/Bazhenator/cleaner/pkg/api/grpc"
@@ -35,41 +38,249 @@ func (s *CleanerServer) ProceedCleaning(ctx context.Context, in *cleaner.Proceed
 	req := in.GetReq()
 
 	answer, err := s.logic.ProceedCleaningRequest(ctx, &dto.ProceedCleaningRequestIn{
-		TeamId: in.GetTeamId(),
-		Request: &dto.Request{
-			Id:           req.GetId(),
-			ClientId:     req.GetClientId(),
-			CleaningType: uint(req.GetCleaningType()),
-			Priority:     uint(req.GetPriority()),
-		},
+		TeamId:  in.GetTeamId(),
+		Request: fromRequest(req),
 	})
 	if err != nil {
 		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
-		return nil, err
+		return nil, toStatus(err)
+	}
+
+	return &cleaner.ProceedCleaningOut{Req: toRequest(answer.Req)}, nil
+}
+
+func (s *CleanerServer) SubmitCleaning(ctx context.Context, in *cleaner.SubmitCleaningIn) (*cleaner.SubmitCleaningOut, error) {
+	s.l.DebugCtx(ctx, "SubmitCleaning started with", logger.NewField("data", in))
+
+	answer, err := s.logic.SubmitCleaningRequest(ctx, fromRequest(in.GetReq()))
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	return &cleaner.SubmitCleaningOut{Req: toRequest(answer)}, nil
+}
+
+func (s *CleanerServer) ScheduleCleaning(ctx context.Context, in *cleaner.ScheduleCleaningIn) (*cleaner.ScheduleCleaningOut, error) {
+	s.l.DebugCtx(ctx, "ScheduleCleaning started with", logger.NewField("data", in))
+
+	answer, err := s.logic.ScheduleCleaningRequest(ctx, &dto.ScheduleCleaningRequestIn{
+		Request:       fromRequest(in.GetReq()),
+		EarliestStart: in.GetEarliestStart().AsTime(),
+		LatestStart:   in.GetLatestStart().AsTime(),
+	})
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	return &cleaner.ScheduleCleaningOut{Req: toRequest(answer.Req)}, nil
+}
+
+func (s *CleanerServer) GetFreeSlots(ctx context.Context, in *cleaner.GetFreeSlotsIn) (*cleaner.GetFreeSlotsOut, error) {
+	s.l.DebugCtx(ctx, "GetFreeSlots started with", logger.NewField("data", in))
+
+	answer, err := s.logic.GetFreeSlots(ctx, &dto.GetFreeSlotsIn{
+		CleaningType: uint(in.GetCleaningType()),
+		From:         in.GetFrom().AsTime(),
+		To:           in.GetTo().AsTime(),
+	})
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	slots := make([]*cleaner.FreeSlot, 0, len(answer.Slots))
+	for _, slot := range answer.Slots {
+		slots = append(slots, &cleaner.FreeSlot{
+			TeamId:   slot.TeamId,
+			Start:    timestamppb.New(slot.Start),
+			End:      timestamppb.New(slot.End),
+			Duration: slot.Duration.Seconds(),
+		})
+	}
+
+	return &cleaner.GetFreeSlotsOut{Slots: slots}, nil
+}
+
+func (s *CleanerServer) CreateSubscription(ctx context.Context, in *cleaner.CreateSubscriptionIn) (*cleaner.CreateSubscriptionOut, error) {
+	s.l.DebugCtx(ctx, "CreateSubscription started with", logger.NewField("data", in))
+
+	answer, err := s.logic.CreateSubscription(ctx, fromSubscription(in.GetSubscription()))
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	return &cleaner.CreateSubscriptionOut{Subscription: toSubscription(answer)}, nil
+}
+
+func (s *CleanerServer) GetSubscription(ctx context.Context, in *cleaner.GetSubscriptionIn) (*cleaner.GetSubscriptionOut, error) {
+	s.l.DebugCtx(ctx, "GetSubscription started with", logger.NewField("data", in))
+
+	answer, err := s.logic.GetSubscription(ctx, in.GetId())
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	return &cleaner.GetSubscriptionOut{Subscription: toSubscription(answer)}, nil
+}
+
+func (s *CleanerServer) CancelSubscription(ctx context.Context, in *cleaner.CancelSubscriptionIn) (*cleaner.CancelSubscriptionOut, error) {
+	s.l.DebugCtx(ctx, "CancelSubscription started with", logger.NewField("data", in))
+
+	answer, err := s.logic.CancelSubscription(ctx, in.GetId())
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	return &cleaner.CancelSubscriptionOut{Subscription: toSubscription(answer)}, nil
+}
+
+func (s *CleanerServer) SkipOccurrence(ctx context.Context, in *cleaner.SkipOccurrenceIn) (*cleaner.SkipOccurrenceOut, error) {
+	s.l.DebugCtx(ctx, "SkipOccurrence started with", logger.NewField("data", in))
+
+	answer, err := s.logic.SkipOccurrence(ctx, &dto.OccurrenceIn{
+		SubscriptionId: in.GetSubscriptionId(),
+		Number:         in.GetNumber(),
+	})
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	return &cleaner.SkipOccurrenceOut{Occurrence: toOccurrence(answer)}, nil
+}
+
+func (s *CleanerServer) RescheduleOccurrence(ctx context.Context, in *cleaner.RescheduleOccurrenceIn) (*cleaner.RescheduleOccurrenceOut, error) {
+	s.l.DebugCtx(ctx, "RescheduleOccurrence started with", logger.NewField("data", in))
+
+	answer, err := s.logic.RescheduleOccurrence(ctx, &dto.OccurrenceIn{
+		SubscriptionId: in.GetSubscriptionId(),
+		Number:         in.GetNumber(),
+		Start:          in.GetStart().AsTime(),
+	})
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	return &cleaner.RescheduleOccurrenceOut{Occurrence: toOccurrence(answer)}, nil
+}
+
+func (s *CleanerServer) GetRequest(ctx context.Context, in *cleaner.GetRequestIn) (*cleaner.GetRequestOut, error) {
+	s.l.DebugCtx(ctx, "GetRequest started with", logger.NewField("data", in))
+
+	answer, err := s.logic.GetRequest(ctx, in.GetId())
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	return &cleaner.GetRequestOut{Req: toRequest(answer.Req)}, nil
+}
+
+// StreamCompletions sends every request, which has been completed or has left the queue without cleaning
+func (s *CleanerServer) StreamCompletions(_ *emptypb.Empty, stream cleaner.CleanerService_StreamCompletionsServer) error {
+	s.l.Debug("StreamCompletions subscribed")
+
+	for e := range s.logic.Subscribe(stream.Context()) {
+		switch e.Type {
+		case dto.EventRequestCompleted, dto.EventRequestReneged, dto.EventRequestBalked, dto.EventRequestFailed, dto.EventRequestCanceled:
+		default:
+			continue
+		}
+
+		err := stream.Send(&cleaner.Completion{
+			Req:  toRequest(e.Request),
+			Time: timestamppb.New(e.Time),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StreamEvents sends service's events in order of their sequence numbers starting from requested offset
+func (s *CleanerServer) StreamEvents(in *cleaner.StreamEventsIn, stream cleaner.CleanerService_StreamEventsServer) error {
+	s.l.Debug("StreamEvents subscribed")
+
+	ctx := stream.Context()
+	offset := in.GetOffset()
+	for {
+		out, err := s.logic.GetEvents(ctx, &dto.GetEventsIn{Offset: offset, Wait: true})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return toStatus(err)
+		}
+
+		for _, e := range out.Events {
+			if err := stream.Send(toEvent(e)); err != nil {
+				return err
+			}
+		}
+		offset = out.Next
+	}
+}
+
+func (s *CleanerServer) GetTimeline(ctx context.Context, in *cleaner.GetTimelineIn) (*cleaner.GetTimelineOut, error) {
+	s.l.DebugCtx(ctx, "GetTimeline started with", logger.NewField("data", in))
+
+	params := &dto.GetTimelineIn{TeamId: in.TeamId}
+	if in.GetFrom() != nil {
+		params.From = in.GetFrom().AsTime()
+	}
+	if in.GetTo() != nil {
+		params.To = in.GetTo().AsTime()
+	}
+
+	answer, err := s.logic.GetTimeline(ctx, params)
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
 	}
 
-	totalTime := answer.Req.TimeInCleaner.Seconds()
+	intervals := make([]*cleaner.Interval, 0, len(answer.Intervals))
+	for _, iv := range answer.Intervals {
+		out := &cleaner.Interval{
+			TeamId:  iv.TeamId,
+			Kind:    cleaner.ActivityKind(iv.Kind),
+			Start:   timestamppb.New(iv.Start),
+			End:     timestamppb.New(iv.End),
+			Ongoing: iv.Ongoing,
+		}
+		if iv.RequestId != 0 {
+			requestId := iv.RequestId
+			out.RequestId = &requestId
+		}
+		intervals = append(intervals, out)
+	}
 
-	return &cleaner.ProceedCleaningOut{Req: &cleaner.Request{
-		Id:            answer.Req.Id,
-		ClientId:      answer.Req.ClientId,
-		Priority:      uint32(answer.Req.Priority),
-		CleaningType:  uint32(answer.Req.CleaningType),
-		TeamId:        &answer.Req.TeamId,
-		TimeInCleaner: &totalTime,
-	}}, nil
+	return &cleaner.GetTimelineOut{Intervals: intervals}, nil
 }
 
-func (s *CleanerServer) GetAvailableTeams(ctx context.Context, _ *emptypb.Empty) (*cleaner.GetAvailableTeamsOut, error) {
+func (s *CleanerServer) GetAvailableTeams(ctx context.Context, in *cleaner.GetAvailableTeamsIn) (*cleaner.GetAvailableTeamsOut, error) {
 	s.l.Debug("GetAvailableTeams requested teams")
 
-	answer, err := s.logic.GetAvailableTeams(ctx)
+	params := &dto.GetAvailableTeamsIn{}
+	if in.CleaningType != nil {
+		cleaningType := uint(in.GetCleaningType())
+		params.CleaningType = &cleaningType
+	}
+
+	answer, err := s.logic.GetAvailableTeams(ctx, params)
 	if err != nil {
 		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
 		return nil, err
 	}
 
-	return &cleaner.GetAvailableTeamsOut{TeamsIds: answer.Teams}, nil
+	return &cleaner.GetAvailableTeamsOut{TeamsIds: answer.Teams, FreeSlots: answer.FreeSlots}, nil
 }
 
 func (s *CleanerServer) GetTeamsStats(ctx context.Context, _ *emptypb.Empty) (*cleaner.GetTeamsStatsOut, error) {
@@ -81,17 +292,329 @@ func (s *CleanerServer) GetTeamsStats(ctx context.Context, _ *emptypb.Empty) (*c
 		return nil, err
 	}
 
-	answer := make([]*cleaner.Team, 0, len(stats.Stats)) 
+	answer := make([]*cleaner.Team, 0, len(stats.Stats))
 	for _, stat := range stats.Stats {
 		totalTime := stat.TotalBusyTime.Seconds()
 
+		var skills map[uint32]float64
+		if stat.Skills != nil {
+			skills = make(map[uint32]float64, len(stat.Skills))
+			for cleaningType, multiplier := range stat.Skills {
+				skills[uint32(cleaningType)] = multiplier
+			}
+		}
+
 		answer = append(answer, &cleaner.Team{
-			Id: stat.Id,
-			Speed: stat.Speed,
-			ProcessedRequests: stat.ProcessedRequests,
-			TotalBusyTime: totalTime,
+			Id:                 stat.Id,
+			Speed:              stat.Speed,
+			ProcessedRequests:  stat.ProcessedRequests,
+			TotalBusyTime:      totalTime,
+			Utilization:        stat.Utilization,
+			Status:             stat.Status,
+			Failures:           stat.Failures,
+			DownTime:           stat.TotalDownTime.Seconds(),
+			Availability:       stat.Availability,
+			OnShift:            stat.OnShift,
+			ShiftTime:          stat.ShiftTime.Seconds(),
+			OnShiftUtilization: stat.OnShiftUtilization,
+			Overtime:           stat.Overtime.Seconds(),
+			Handoffs:           stat.Handoffs,
+			Skills:             skills,
+			Capacity:           stat.Capacity,
+			FreeSlots:          stat.FreeSlots,
+			ActiveRequests:     stat.ActiveRequests,
+			Reworks:            stat.Reworks,
+			ReworkRate:         stat.ReworkRate,
+			TravelTime:         stat.TravelTime.Seconds(),
+			Position:           toLocation(stat.Position),
+			Cost:               stat.Cost,
+			Revenue:            stat.Revenue,
 		})
 	}
 
 	return &cleaner.GetTeamsStatsOut{Teams: answer}, nil
 }
+
+func (s *CleanerServer) GetClientStats(ctx context.Context, in *cleaner.GetClientStatsIn) (*cleaner.GetClientStatsOut, error) {
+	s.l.DebugCtx(ctx, "GetClientStats started with", logger.NewField("data", in))
+
+	answer, err := s.logic.GetClientStats(ctx, &dto.GetClientStatsIn{ClientId: in.ClientId})
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	clients := make([]*cleaner.ClientStats, 0, len(answer.Stats))
+	for _, stat := range answer.Stats {
+		clients = append(clients, &cleaner.ClientStats{
+			ClientId:         stat.ClientId,
+			Weight:           stat.Weight,
+			Arrivals:         stat.Arrivals,
+			Completed:        stat.Completed,
+			Rejected:         stat.Rejected,
+			Reneged:          stat.Reneged,
+			Waiting:          stat.Waiting,
+			MeanWaitTime:     stat.MeanWaitTime.Seconds(),
+			MeanCleaningTime: stat.MeanCleaningTime.Seconds(),
+			MeanResponseTime: stat.MeanResponseTime.Seconds(),
+		})
+	}
+
+	return &cleaner.GetClientStatsOut{Clients: clients}, nil
+}
+
+func (s *CleanerServer) GetSystemStats(ctx context.Context, _ *emptypb.Empty) (*cleaner.GetSystemStatsOut, error) {
+	s.l.Debug("GetSystemStats requested stats")
+
+	stats, err := s.logic.GetSystemStats(ctx)
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, err
+	}
+
+	stages := make([]*cleaner.StageStats, 0, len(stats.Stages))
+	for _, st := range stats.Stages {
+		stages = append(stages, &cleaner.StageStats{
+			Name:              st.Name,
+			Completed:         st.Completed,
+			MeanWaitTime:      st.MeanWaitTime.Seconds(),
+			MeanTimeInCleaner: st.MeanTimeInCleaner.Seconds(),
+		})
+	}
+
+	return &cleaner.GetSystemStatsOut{
+		Elapsed:            stats.Elapsed.Seconds(),
+		Arrivals:           stats.Arrivals,
+		Started:            stats.Started,
+		Completed:          stats.Completed,
+		QueueLength:        stats.QueueLength,
+		MaxQueueLength:     stats.MaxQueueLength,
+		MeanQueueLength:    stats.MeanQueueLength,
+		MeanWaitTime:       stats.MeanWaitTime.Seconds(),
+		MeanResponseTime:   stats.MeanResponseTime.Seconds(),
+		Throughput:         stats.Throughput,
+		Utilization:        stats.Utilization,
+		Rejected:           stats.Rejected,
+		Reneged:            stats.Reneged,
+		Balked:             stats.Balked,
+		Interrupted:        stats.Interrupted,
+		Failed:             stats.Failed,
+		Availability:       stats.Availability,
+		Handoffs:           stats.Handoffs,
+		Overtime:           stats.Overtime.Seconds(),
+		OnShiftUtilization: stats.OnShiftUtilization,
+		Stages:             stages,
+		Reworked:           stats.Reworked,
+		ReworkRate:         stats.ReworkRate,
+		TravelTime:         stats.TravelTime.Seconds(),
+		MeanTravelTime:     stats.MeanTravelTime.Seconds(),
+		Scheduled:          stats.Scheduled,
+		Canceled:           stats.Canceled,
+		Cost:               stats.Cost,
+		Revenue:            stats.Revenue,
+		Profit:             stats.Profit,
+	}, nil
+}
+
+func (s *CleanerServer) GetTheoreticalMetrics(ctx context.Context, in *cleaner.GetTheoreticalMetricsIn) (*cleaner.GetTheoreticalMetricsOut, error) {
+	s.l.DebugCtx(ctx, "GetTheoreticalMetrics started with", logger.NewField("data", in))
+
+	params := &dto.GetTheoreticalMetricsIn{
+		ArrivalRate:   in.GetArrivalRate(),
+		QueueCapacity: -1,
+		ServiceScv:    1,
+	}
+	if in.QueueCapacity != nil {
+		params.QueueCapacity = int(in.GetQueueCapacity())
+	}
+	if in.ServiceScv != nil {
+		params.ServiceScv = in.GetServiceScv()
+	}
+
+	answer, err := s.logic.GetTheoreticalMetrics(ctx, params)
+	if err != nil {
+		s.l.ErrorCtx(ctx, "error occurred:", logger.NewErrorField(err))
+		return nil, toStatus(err)
+	}
+
+	models := make([]*cleaner.TheoreticalModel, 0, len(answer.Models))
+	for _, m := range answer.Models {
+		model := &cleaner.TheoreticalModel{Model: m.Name}
+		if m.Err != nil {
+			model.Error = m.Err.Error()
+		} else {
+			model.Utilization = m.Metrics.Utilization
+			model.WaitProbability = m.Metrics.WaitProbability
+			model.BlockingProbability = m.Metrics.BlockingProbability
+			model.MeanQueueLength = m.Metrics.Lq
+			model.MeanInSystem = m.Metrics.L
+			model.MeanWaitTime = m.Metrics.Wq
+			model.MeanResponseTime = m.Metrics.W
+			model.Throughput = m.Metrics.Throughput
+		}
+		models = append(models, model)
+	}
+
+	return &cleaner.GetTheoreticalMetricsOut{
+		ArrivalRate:     answer.ArrivalRate,
+		MeanServiceRate: answer.MeanServiceRate,
+		Teams:           answer.Teams,
+		Models:          models,
+	}, nil
+}
+
+// fromRequest converts API's request to logic's one
+func fromRequest(req *cleaner.Request) *dto.Request {
+	out := &dto.Request{
+		Id:           req.GetId(),
+		ClientId:     req.GetClientId(),
+		CleaningType: uint(req.GetCleaningType()),
+		Priority:     uint(req.GetPriority()),
+		Patience:     time.Duration(req.GetPatience() * float64(time.Second)),
+	}
+	if req.GetDeadline() != nil {
+		out.Deadline = req.GetDeadline().AsTime()
+	}
+	if loc := req.GetLocation(); loc != nil {
+		out.Location = &dto.Location{X: loc.GetX(), Y: loc.GetY(), Site: loc.GetSite()}
+	}
+
+	return out
+}
+
+// fromSubscription converts API's subscription to logic's one
+func fromSubscription(sub *cleaner.Subscription) *dto.Subscription {
+	out := &dto.Subscription{
+		Id:           sub.GetId(),
+		ClientId:     sub.GetClientId(),
+		CleaningType: uint(sub.GetCleaningType()),
+		Priority:     uint(sub.GetPriority()),
+		Rule:         sub.GetRule(),
+		Window:       time.Duration(sub.GetWindow() * float64(time.Second)),
+		SameTeam:     sub.GetSameTeam(),
+	}
+	if sub.GetStart() != nil {
+		out.Start = sub.GetStart().AsTime()
+	}
+	if loc := sub.GetLocation(); loc != nil {
+		out.Location = &dto.Location{X: loc.GetX(), Y: loc.GetY(), Site: loc.GetSite()}
+	}
+
+	return out
+}
+
+// toSubscription converts logic's subscription to API's one
+func toSubscription(sub *dto.Subscription) *cleaner.Subscription {
+	out := &cleaner.Subscription{
+		Id:           sub.Id,
+		ClientId:     sub.ClientId,
+		CleaningType: uint32(sub.CleaningType),
+		Priority:     uint32(sub.Priority),
+		Rule:         sub.Rule,
+		Start:        timestamppb.New(sub.Start),
+		Window:       sub.Window.Seconds(),
+		SameTeam:     sub.SameTeam,
+		Canceled:     sub.Canceled,
+	}
+	if sub.Location != nil {
+		out.Location = toLocation(*sub.Location)
+	}
+	for _, occ := range sub.Occurrences {
+		out.Occurrences = append(out.Occurrences, toOccurrence(occ))
+	}
+
+	return out
+}
+
+func toOccurrence(occ *dto.Occurrence) *cleaner.Occurrence {
+	out := &cleaner.Occurrence{
+		Number:  occ.Number,
+		Start:   timestamppb.New(occ.Start),
+		Skipped: occ.Skipped,
+	}
+	if occ.Request != nil {
+		out.Req = toRequest(occ.Request)
+	}
+
+	return out
+}
+
+// toEvent converts logic's event to API's one named as in event log
+func toEvent(e *dto.Event) *cleaner.Event {
+	out := &cleaner.Event{
+		Seq:            e.Seq,
+		Time:           timestamppb.New(e.Time),
+		Type:           eventlog.TypeName(e.Type),
+		TeamId:         e.TeamId,
+		AvailableTeams: e.AvailableTeams,
+	}
+	if e.TeamId != nil {
+		out.TeamStatus = eventlog.TeamStatusName(e.TeamStatus)
+	}
+	if e.Request != nil {
+		out.Request = toRequest(e.Request)
+	}
+
+	return out
+}
+
+func toLocation(loc dto.Location) *cleaner.Location {
+	return &cleaner.Location{X: loc.X, Y: loc.Y, Site: loc.Site}
+}
+
+// toRequest converts logic's request to API's one
+func toRequest(req *dto.Request) *cleaner.Request {
+	out := &cleaner.Request{
+		Id:            req.Id,
+		ClientId:      req.ClientId,
+		Priority:      uint32(req.Priority),
+		CleaningType:  uint32(req.CleaningType),
+		Status:        cleaner.RequestStatus(req.Status),
+		SubmittedAt:   timestamppb.New(req.SubmittedAt),
+		Interruptions: uint32(req.Interruptions),
+		Stage:         uint32(req.Stage),
+		Reworks:       uint32(req.Reworks),
+
+		SubscriptionId: req.SubscriptionId,
+		Cost:           req.Cost,
+		Revenue:        req.Revenue,
+	}
+	for _, st := range req.Stages {
+		out.Stages = append(out.Stages, &cleaner.StageTime{
+			Name:          st.Name,
+			TeamId:        st.TeamId,
+			WaitTime:      st.WaitTime.Seconds(),
+			TimeInCleaner: st.TimeInCleaner.Seconds(),
+		})
+	}
+	if req.Patience > 0 {
+		patience := req.Patience.Seconds()
+		out.Patience = &patience
+	}
+	if !req.Deadline.IsZero() {
+		out.Deadline = timestamppb.New(req.Deadline)
+	}
+	if req.Location != nil {
+		out.Location = toLocation(*req.Location)
+	}
+	if !req.FinishedAt.IsZero() {
+		out.FinishedAt = timestamppb.New(req.FinishedAt)
+	}
+	if !req.ScheduledAt.IsZero() {
+		out.ScheduledAt = timestamppb.New(req.ScheduledAt)
+	}
+
+	// Team, cleaning and waiting times are known since cleaning has started
+	switch req.Status {
+	case dto.RequestInProgress, dto.RequestCompleted:
+		timeInCleaner, waitTime, travelTime := req.TimeInCleaner.Seconds(), req.WaitTime.Seconds(), req.TravelTime.Seconds()
+		out.TeamId = &req.TeamId
+		out.TimeInCleaner = &timeInCleaner
+		out.WaitTime = &waitTime
+		out.TravelTime = &travelTime
+	case dto.RequestScheduled:
+		out.TeamId = &req.TeamId
+	}
+
+	return out
+}
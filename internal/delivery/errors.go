@@ -0,0 +1,38 @@
+package delivery
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Bazhenator/cleaner/internal/logic"
+)
+
+// toStatus converts logic's error to grpc status error with corresponding code
+func toStatus(err error) error {
+	var code codes.Code
+	switch {
+	case errors.Is(err, logic.ErrNilRequest), errors.Is(err, logic.ErrNoArrivals), errors.Is(err, logic.ErrInvalidLocation),
+		errors.Is(err, logic.ErrInvalidWindow), errors.Is(err, logic.ErrInvalidRule), errors.Is(err, logic.ErrInvalidSubscription):
+		code = codes.InvalidArgument
+	case errors.Is(err, logic.ErrTeamNotFound), errors.Is(err, logic.ErrRequestNotFound),
+		errors.Is(err, logic.ErrSubscriptionNotFound), errors.Is(err, logic.ErrOccurrenceNotFound):
+		code = codes.NotFound
+	case errors.Is(err, logic.ErrTeamBusy), errors.Is(err, logic.ErrTeamUnavailable),
+		errors.Is(err, logic.ErrTeamOffShift), errors.Is(err, logic.ErrTeamIneligible),
+		errors.Is(err, logic.ErrSubscriptionCanceled), errors.Is(err, logic.ErrOccurrenceClosed):
+		code = codes.FailedPrecondition
+	case errors.Is(err, logic.ErrSubscriptionExists), errors.Is(err, logic.ErrRequestExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, logic.ErrQueueFull), errors.Is(err, logic.ErrBalked), errors.Is(err, logic.ErrNoFreeSlot),
+		errors.Is(err, logic.ErrRateLimited), errors.Is(err, logic.ErrQuotaExceeded):
+		code = codes.ResourceExhausted
+	case errors.Is(err, logic.ErrEventsExpired):
+		code = codes.OutOfRange
+	default:
+		return err
+	}
+
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,44 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Bazhenator/cleaner/internal/logic"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{logic.ErrNilRequest, codes.InvalidArgument},
+		{fmt.Errorf("%w: team 7", logic.ErrTeamNotFound), codes.NotFound},
+		{fmt.Errorf("%w: request 7", logic.ErrRequestNotFound), codes.NotFound},
+		{fmt.Errorf("%w: team 0", logic.ErrTeamBusy), codes.FailedPrecondition},
+		{fmt.Errorf("%w: team 0", logic.ErrTeamUnavailable), codes.FailedPrecondition},
+		{fmt.Errorf("%w: team 0", logic.ErrTeamOffShift), codes.FailedPrecondition},
+		{fmt.Errorf("%w: queue is full, 0 requests are waiting", logic.ErrQueueFull), codes.ResourceExhausted},
+		{fmt.Errorf("%w: 3 requests are waiting", logic.ErrBalked), codes.ResourceExhausted},
+		{fmt.Errorf("%w: client 1 is limited to 2 requests per second", logic.ErrRateLimited), codes.ResourceExhausted},
+		{fmt.Errorf("%w: client 1 has 3 requests waiting", logic.ErrQuotaExceeded), codes.ResourceExhausted},
+		{fmt.Errorf("%w: request 1 has different payload", logic.ErrRequestExists), codes.AlreadyExists},
+		{errors.New("unknown"), codes.Unknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			err := toStatus(tt.err)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %s, want %s", code, tt.code)
+			}
+			if msg := status.Convert(err).Message(); msg != tt.err.Error() {
+				t.Fatalf("message = %q, want %q", msg, tt.err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/Bazhenator/cleaner/configs"
+	cleaner "github.com/Bazhenator/cleaner/pkg/api/grpc"
+	"github.com/Bazhenator/cleaner/pkg/api/openapi"
+	"github.com/Bazhenator/tools/src/logger"
+)
+
+const openApiPath = "/v1/openapi.json"
+
+// NewGatewayServer creates HTTP/JSON facade, which proxies REST calls to cleaner's grpc listener
+func NewGatewayServer(ctx context.Context, c *configs.Config, l *logger.Logger) (*http.Server, error) {
+	mux := runtime.NewServeMux()
+
+	endpoint := net.JoinHostPort(c.Grpc.Host, c.Grpc.Port)
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(c.Grpc.MaxReceiveSize),
+			grpc.MaxCallSendMsgSize(c.Grpc.MaxSendSize),
+		),
+	}
+	if err := cleaner.RegisterCleanerServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return nil, fmt.Errorf("failed to register gateway: %w", err)
+	}
+
+	err := mux.HandlePath(http.MethodGet, openApiPath, func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(openapi.Spec); err != nil {
+			l.Error("failed to write openapi spec", logger.NewErrorField(err))
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register openapi spec: %w", err)
+	}
+
+	return &http.Server{
+		Addr:    net.JoinHostPort(c.Http.Host, c.Http.Port),
+		Handler: mux,
+	}, nil
+}
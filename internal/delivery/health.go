@@ -0,0 +1,103 @@
+package delivery
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/Bazhenator/cleaner/internal/logic"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	cleaner "github.com/Bazhenator/cleaner/pkg/api/grpc"
+	"github.com/Bazhenator/tools/src/logger"
+)
+
+// ReadinessService is a health service name, which reports SERVING only while at least one team is available.
+// Clients may use it in healthCheckConfig to route around saturated cleaners
+const ReadinessService = "cleaner.CleanerService.Readiness"
+
+// readinessRefresh is a period readiness is recomputed at, so an event dropped for slow subscriber does not
+// leave status stale on an idle service
+const readinessRefresh = 5 * time.Second
+
+// HealthServer is a standard grpc.health.v1 server, which follows cleaning service's state
+type HealthServer struct {
+	*health.Server
+
+	l *logger.Logger
+
+	logic logic.CleanerService
+}
+
+func NewHealthServer(l *logger.Logger, logic logic.CleanerService) *HealthServer {
+	s := &HealthServer{
+		Server: health.NewServer(),
+		l:      l,
+
+		logic: logic,
+	}
+
+	// Nothing is served until teams are initialized
+	s.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	s.SetServingStatus(cleaner.CleanerService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	s.SetServingStatus(ReadinessService, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return s
+}
+
+// Run marks cleaner as serving and keeps readiness status up to date until ctx is done.
+// Events only wake it up, readiness is always recomputed from available teams, since the event bus
+// drops events for slow subscribers
+func (s *HealthServer) Run(ctx context.Context) {
+	events := s.logic.Subscribe(ctx)
+
+	if err := s.refreshReadiness(ctx); err != nil {
+		s.l.ErrorCtx(ctx, "cleaner is not ready to serve:", logger.NewErrorField(err))
+		return
+	}
+
+	s.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	s.SetServingStatus(cleaner.CleanerService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	ticker := time.NewTicker(readinessRefresh)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+		case <-ticker.C:
+		}
+
+		if err := s.refreshReadiness(ctx); err != nil && ctx.Err() == nil {
+			s.l.ErrorCtx(ctx, "failed to refresh readiness:", logger.NewErrorField(err))
+		}
+	}
+}
+
+func (s *HealthServer) refreshReadiness(ctx context.Context) error {
+	teams, err := s.logic.GetAvailableTeams(ctx, &dto.GetAvailableTeamsIn{})
+	if err != nil {
+		return err
+	}
+
+	s.setReadiness(uint64(len(teams.Teams)))
+	return nil
+}
+
+// Drain marks every service as NOT_SERVING and ignores further updates
+func (s *HealthServer) Drain() {
+	s.Shutdown()
+}
+
+func (s *HealthServer) setReadiness(availableTeams uint64) {
+	if availableTeams > 0 {
+		s.SetServingStatus(ReadinessService, healthpb.HealthCheckResponse_SERVING)
+		return
+	}
+
+	s.SetServingStatus(ReadinessService, healthpb.HealthCheckResponse_NOT_SERVING)
+}
@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// StartShift brings the team to work
+func (ct *CleaningTeam) StartShift(now time.Time) {
+	ct.OnShift = true
+	ct.ShiftStartedAt = now
+	ct.refreshStatus()
+}
+
+// EndShift takes the team off work. Team with active requests or trips finishes them in overtime
+func (ct *CleaningTeam) EndShift(now time.Time) {
+	ct.TotalShiftTime += ct.ShiftTimeSince(now)
+	ct.OnShift = false
+
+	if ct.Status != Unavailable && ct.occupied() > 0 {
+		ct.Overtime = true
+		ct.OvertimeStartedAt = now
+	}
+	ct.refreshStatus()
+}
+
+// Handoff leaves cleanings unfinished for other teams.
+// Returns unfinished requests, none if team has been idle
+func (ct *CleaningTeam) Handoff(now time.Time) []*dto.Request {
+	unfinished := ct.releaseAll(now)
+	ct.Handoffs += uint64(len(unfinished))
+	ct.refreshStatus()
+
+	return unfinished
+}
+
+// ShiftTimeSince returns time spent on current shift since statistics are collected
+func (ct *CleaningTeam) ShiftTimeSince(now time.Time) time.Duration {
+	if !ct.OnShift {
+		return 0
+	}
+
+	return now.Sub(latest(ct.ShiftStartedAt, ct.StatsSince))
+}
+
+// OvertimeSince returns time spent on current overtime since statistics are collected
+func (ct *CleaningTeam) OvertimeSince(now time.Time) time.Duration {
+	if !ct.Overtime {
+		return 0
+	}
+
+	return now.Sub(latest(ct.OvertimeStartedAt, ct.StatsSince))
+}
+
+func (ct *CleaningTeam) stopOvertime(now time.Time) {
+	ct.TotalOvertime += ct.OvertimeSince(now)
+	ct.Overtime = false
+}
+
+// refreshStatus derives status of working team from its shift and occupied slots, broken team stays unavailable
+func (ct *CleaningTeam) refreshStatus() {
+	switch {
+	case ct.Status == Unavailable:
+	case !ct.OnShift && ct.occupied() == 0:
+		ct.Status = OffShift
+	case !ct.OnShift || ct.occupied() >= ct.Capacity:
+		ct.Status = Busy
+	default:
+		ct.Status = Available
+	}
+}
+
+func latest(first time.Time, rest ...time.Time) time.Time {
+	for _, t := range rest {
+		if t.After(first) {
+			first = t
+		}
+	}
+
+	return first
+}
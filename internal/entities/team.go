@@ -18,37 +18,207 @@ const (
 type Status byte // Status is a special type wich describes cleaning team's busyness
 
 const (
-	Available Status = iota
-	Busy
+	Available   Status = iota // Available team has at least one free slot
+	Busy                      // Busy team has all its slots occupied
+	Unavailable               // Unavailable team has broken down and is being repaired
+	OffShift                  // OffShift team is out of its working hours
 )
 
 type CleaningTeam struct {
-	Id                 uint64
-	Request            *dto.Request
-	Status             Status
-	Speed              Speed
-	ProcessedRequests  uint64
-	TotalBusyTime      time.Duration
-	StartedAt          time.Time
+	Id                uint64
+	Requests          []*dto.Request // Requests are requests being cleaned, one per occupied slot
+	Capacity          uint64         // Capacity is an amount of requests team cleans at once
+	Status            Status
+	Speed             Speed
+	Skills            map[uint]float64 // Skills are cleaning types team is eligible for with speed multipliers, team cleans any type if nil
+	ProcessedRequests uint64
+	TotalBusyTime     time.Duration // TotalBusyTime is a sum of busy times of team's slots
+	TotalOvertimeBusy time.Duration // TotalOvertimeBusy is a part of TotalBusyTime spent in overtime
+	StatsSince        time.Time     // StatsSince is a moment statistics are collected from
+	Failures          uint64
+	TotalDownTime     time.Duration
+	FailedAt          time.Time
+	OnShift           bool
+	ShiftStartedAt    time.Time
+	TotalShiftTime    time.Duration
+	Overtime          bool // Overtime is set while team finishes cleaning after its shift has ended
+	OvertimeStartedAt time.Time
+	TotalOvertime     time.Duration
+	Handoffs          uint64 // Handoffs is an amount of cleanings left unfinished for another team at shift's end
+	Reworks           uint64 // Reworks is an amount of team's cleanings, which have failed inspection
+	Base              dto.Location
+	Position          dto.Location  // Position is a location team is at or is heading to
+	Trips             []*Trip       // Trips are team's return trips to base, one per occupied slot
+	TotalTravelTime   time.Duration // TotalTravelTime is a part of TotalBusyTime spent on the way
+	Timeline          Timeline      // Timeline is team's capacity booked for scheduled requests
+	Revenue           float64       // Revenue is a sum of prices of requests completed by team
 }
 
-// AssignRequest assigns a cleaning request to the team
-func (ct *CleaningTeam) AssignRequest(req *dto.Request) {
-	ct.Request = req
-	ct.Status = Busy
-	ct.Request.TeamId = ct.Id
-	ct.StartedAt = time.Now()
+// AssignRequest assigns a cleaning request to a free slot of the team
+func (ct *CleaningTeam) AssignRequest(req *dto.Request, now time.Time) {
+	req.TeamId = ct.Id
+	req.StartedAt = now
+	ct.Requests = append(ct.Requests, req)
+	ct.refreshStatus()
 }
 
-// CompleteCleaning marks the cleaning as completed
-func (ct *CleaningTeam) CompleteCleaning(timer time.Time) {
-	ct.Status = Available
+// CompleteCleaning marks the cleaning of request as completed and frees its slot
+func (ct *CleaningTeam) CompleteCleaning(req *dto.Request, now time.Time) {
+	ct.release(req, now)
 	ct.ProcessedRequests += 1
-	ct.TotalBusyTime += time.Since(timer)
+	ct.refreshStatus()
+}
+
+// Fail breaks the team down interrupting its cleanings.
+// Returns interrupted requests, none if team has been idle
+func (ct *CleaningTeam) Fail(now time.Time) []*dto.Request {
+	interrupted := ct.releaseAll(now)
+	ct.abortTrips(now)
+	ct.Status = Unavailable
+	ct.Failures += 1
+	ct.FailedAt = now
+
+	return interrupted
+}
+
+// Repair makes broken team available again, if it is on shift
+func (ct *CleaningTeam) Repair(now time.Time) {
+	ct.TotalDownTime += ct.DownTimeSince(now)
+	ct.Status = Available
+	ct.refreshStatus()
+}
+
+// DownTimeSince returns time spent on current repair since statistics are collected
+func (ct *CleaningTeam) DownTimeSince(now time.Time) time.Duration {
+	if ct.Status != Unavailable {
+		return 0
+	}
+
+	if ct.FailedAt.Before(ct.StatsSince) {
+		return now.Sub(ct.StatsSince)
+	}
+
+	return now.Sub(ct.FailedAt)
+}
+
+// BusyTimeSince returns time spent on current cleanings and trips by all slots since statistics are collected
+func (ct *CleaningTeam) BusyTimeSince(now time.Time) time.Duration {
+	var busy time.Duration
+	for _, start := range ct.slotsStarts() {
+		slotBusy, _ := ct.slotBusyTime(start, now)
+		busy += slotBusy
+	}
+
+	return busy
+}
+
+// OvertimeBusySince returns time spent on current cleanings and trips by all slots in overtime since statistics are collected
+func (ct *CleaningTeam) OvertimeBusySince(now time.Time) time.Duration {
+	var busy time.Duration
+	for _, start := range ct.slotsStarts() {
+		_, overtime := ct.slotBusyTime(start, now)
+		busy += overtime
+	}
+
+	return busy
+}
+
+// FreeSlots returns an amount of requests team is able to take now
+func (ct *CleaningTeam) FreeSlots() uint64 {
+	if ct.Status != Available {
+		return 0
+	}
+
+	return ct.Capacity - ct.occupied()
+}
+
+// CanTake reports whether team has a free slot for cleaning during [start, end), which is not booked by reservations
+func (ct *CleaningTeam) CanTake(start, end time.Time) bool {
+	return ct.FreeSlots() > ct.Timeline.MaxLoad(start, end)
+}
+
+// occupied returns an amount of slots occupied by cleanings and trips
+func (ct *CleaningTeam) occupied() uint64 {
+	return uint64(len(ct.Requests) + len(ct.Trips))
 }
 
-// GetCleaningTime calculates the cleaning duration based on team speed and exponential distribution
-func (ct *CleaningTeam) GetCleaningTime(defSpeed uint64) time.Duration {
+// slotsStarts returns moments occupied slots have been taken at
+func (ct *CleaningTeam) slotsStarts() []time.Time {
+	starts := make([]time.Time, 0, ct.occupied())
+	for _, req := range ct.Requests {
+		starts = append(starts, req.StartedAt)
+	}
+	for _, trip := range ct.Trips {
+		starts = append(starts, trip.StartedAt)
+	}
+
+	return starts
+}
+
+// slotBusyTime returns time slot taken at start has been occupied since statistics are collected and the part of it in overtime
+func (ct *CleaningTeam) slotBusyTime(start, now time.Time) (busy, overtime time.Duration) {
+	busy = now.Sub(latest(start, ct.StatsSince))
+	if ct.Overtime {
+		overtime = now.Sub(latest(start, ct.OvertimeStartedAt, ct.StatsSince))
+	}
+
+	return busy, overtime
+}
+
+// freeSlot accounts busy time of slot taken at start, which is freed
+func (ct *CleaningTeam) freeSlot(start, now time.Time) {
+	busy, overtime := ct.slotBusyTime(start, now)
+	ct.TotalBusyTime += busy
+	ct.TotalOvertimeBusy += overtime
+}
+
+// release frees slot of request accounting its busy time
+func (ct *CleaningTeam) release(req *dto.Request, now time.Time) {
+	ct.freeSlot(req.StartedAt, now)
+	for i, active := range ct.Requests {
+		if active == req {
+			ct.Requests = append(ct.Requests[:i], ct.Requests[i+1:]...)
+			break
+		}
+	}
+	if ct.occupied() == 0 {
+		ct.stopOvertime(now)
+	}
+}
+
+// releaseAll frees every slot occupied by cleaning. Returns requests slots have been occupied by
+func (ct *CleaningTeam) releaseAll(now time.Time) []*dto.Request {
+	released := ct.Requests
+	for _, req := range released {
+		ct.freeSlot(req.StartedAt, now)
+	}
+
+	ct.Requests = nil
+	if ct.occupied() == 0 {
+		ct.stopOvertime(now)
+	}
+
+	return released
+}
+
+// ResetStats starts collecting team's statistics anew
+func (ct *CleaningTeam) ResetStats(now time.Time) {
+	ct.ProcessedRequests = 0
+	ct.TotalBusyTime = 0
+	ct.TotalOvertimeBusy = 0
+	ct.Failures = 0
+	ct.TotalDownTime = 0
+	ct.TotalShiftTime = 0
+	ct.TotalOvertime = 0
+	ct.Handoffs = 0
+	ct.Reworks = 0
+	ct.TotalTravelTime = 0
+	ct.Revenue = 0
+	ct.StatsSince = now
+}
+
+// MeanCleaningTime returns mean cleaning duration based on team speed
+func (ct *CleaningTeam) MeanCleaningTime(defSpeed uint64) time.Duration {
 	baseTime := time.Duration(defSpeed) * time.Second // Base cleaning time for Slow speed
 
 	switch ct.Speed {
@@ -57,8 +227,32 @@ func (ct *CleaningTeam) GetCleaningTime(defSpeed uint64) time.Duration {
 	case Fast:
 		baseTime /= 4
 	}
+
+	return baseTime
+}
+
+// CanClean reports whether team is eligible for cleaning type
+func (ct *CleaningTeam) CanClean(cleaningType uint) bool {
+	if ct.Skills == nil {
+		return true
+	}
+
+	_, ok := ct.Skills[cleaningType]
+	return ok
+}
+
+// MeanCleaningTimeOf returns mean cleaning duration of cleaning type based on team speed and skill
+func (ct *CleaningTeam) MeanCleaningTimeOf(defSpeed uint64, cleaningType uint) time.Duration {
+	meanTime := ct.MeanCleaningTime(defSpeed)
+	if multiplier, ok := ct.Skills[cleaningType]; ok {
+		meanTime = time.Duration(float64(meanTime) / multiplier)
+	}
+
+	return meanTime
+}
+
+// GetCleaningTime calculates the cleaning duration of cleaning type based on team speed, skill and exponential distribution
+func (ct *CleaningTeam) GetCleaningTime(rng *rand.Rand, defSpeed uint64, cleaningType uint) time.Duration {
 	// Exponential distribution simulation
-	lambda := 1 / float64(baseTime.Seconds())
-	expTime := time.Duration(rand.ExpFloat64()/lambda) * time.Second
-	return expTime
+	return time.Duration(rng.ExpFloat64() * float64(ct.MeanCleaningTimeOf(defSpeed, cleaningType)))
 }
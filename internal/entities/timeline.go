@@ -0,0 +1,121 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+// Interval is a period of time [Start, End)
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Reservation is a team's slot booked for scheduled request
+type Reservation struct {
+	RequestId uint64
+	Interval
+}
+
+// Timeline is a team's future reservations ordered by start
+type Timeline struct {
+	Reservations []*Reservation
+}
+
+// Reserve books slot for reservation
+func (tl *Timeline) Reserve(r *Reservation) {
+	i, _ := slices.BinarySearchFunc(tl.Reservations, r, func(a, b *Reservation) int { return a.Start.Compare(b.Start) })
+	tl.Reservations = slices.Insert(tl.Reservations, i, r)
+}
+
+// Cancel frees slot booked for request. Returns false if request has no reservation
+func (tl *Timeline) Cancel(requestId uint64) bool {
+	i := slices.IndexFunc(tl.Reservations, func(r *Reservation) bool { return r.RequestId == requestId })
+	if i < 0 {
+		return false
+	}
+
+	tl.Reservations = slices.Delete(tl.Reservations, i, i+1)
+	return true
+}
+
+// MaxLoad returns max amount of reservations overlapping at once during [start, end)
+func (tl *Timeline) MaxLoad(start, end time.Time) uint64 {
+	var load, maxLoad uint64
+	for _, p := range tl.points(start, end) {
+		if p.delta > 0 {
+			load++
+			maxLoad = max(maxLoad, load)
+		} else {
+			load--
+		}
+	}
+
+	return maxLoad
+}
+
+// Free returns intervals inside [from, to), during which less than capacity reservations and busy intervals overlap.
+// Busy intervals are slots occupied by activities, which are not reserved, e.g. running cleanings
+func (tl *Timeline) Free(from, to time.Time, capacity uint64, busy ...Interval) []Interval {
+	var free []Interval
+	var load uint64
+	freeSince := from
+	for _, p := range tl.points(from, to, busy...) {
+		if p.delta > 0 {
+			load++
+			if load == capacity && p.at.After(freeSince) {
+				free = append(free, Interval{Start: freeSince, End: p.at})
+			}
+		} else {
+			if load == capacity {
+				freeSince = p.at
+			}
+			load--
+		}
+	}
+	if load < capacity && to.After(freeSince) {
+		free = append(free, Interval{Start: freeSince, End: to})
+	}
+
+	return free
+}
+
+// point is a start or an end of reservation clipped by interval
+type point struct {
+	at    time.Time
+	delta int
+}
+
+// points returns starts and ends of reservations and busy intervals overlapping [start, end) clipped by it
+// in order of time, ends go first
+func (tl *Timeline) points(start, end time.Time, busy ...Interval) []point {
+	points := make([]point, 0, 2*(len(tl.Reservations)+len(busy)))
+	add := func(in Interval) {
+		if !in.End.After(start) || !in.Start.Before(end) {
+			return
+		}
+		points = append(points, point{at: latest(in.Start, start), delta: 1}, point{at: earliest(in.End, end), delta: -1})
+	}
+	for _, r := range tl.Reservations {
+		add(r.Interval)
+	}
+	for _, in := range busy {
+		add(in)
+	}
+	slices.SortFunc(points, func(a, b point) int {
+		if c := a.at.Compare(b.at); c != 0 {
+			return c
+		}
+		return a.delta - b.delta
+	})
+
+	return points
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"time"
+)
+
+// Trip is a team's return trip to base, which occupies a slot
+type Trip struct {
+	StartedAt time.Time
+	ArrivesAt time.Time // ArrivesAt is a moment team reaches base
+}
+
+// StartReturn sends team's slot back to base after cleaning, team reaches it at arrival.
+// Returns trip, which is to be ended when team reaches base
+func (ct *CleaningTeam) StartReturn(now, arrival time.Time) *Trip {
+	trip := &Trip{StartedAt: now, ArrivesAt: arrival}
+	ct.Trips = append(ct.Trips, trip)
+	ct.refreshStatus()
+
+	return trip
+}
+
+// EndReturn frees slot of trip, team is at base if it has nothing else to do.
+// Returns false if trip has been aborted by team's breakdown
+func (ct *CleaningTeam) EndReturn(trip *Trip, now time.Time) bool {
+	i := -1
+	for j, t := range ct.Trips {
+		if t == trip {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		return false
+	}
+
+	busy, _ := ct.slotBusyTime(trip.StartedAt, now)
+	ct.TotalTravelTime += busy
+	ct.freeSlot(trip.StartedAt, now)
+	ct.Trips = append(ct.Trips[:i], ct.Trips[i+1:]...)
+	if len(ct.Requests) == 0 {
+		ct.Position = ct.Base
+	}
+	if ct.occupied() == 0 {
+		ct.stopOvertime(now)
+	}
+	ct.refreshStatus()
+
+	return true
+}
+
+// abortTrips drops team's return trips accounting their travel time
+func (ct *CleaningTeam) abortTrips(now time.Time) {
+	for _, trip := range ct.Trips {
+		busy, _ := ct.slotBusyTime(trip.StartedAt, now)
+		ct.TotalTravelTime += busy
+		ct.freeSlot(trip.StartedAt, now)
+	}
+
+	ct.Trips = nil
+	ct.stopOvertime(now)
+}
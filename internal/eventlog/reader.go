@@ -0,0 +1,150 @@
+package eventlog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"slices"
+)
+
+// maxLineSize limits a single record's line
+const maxLineSize = 1 << 20
+
+// Files returns files of event log from the oldest rotated one to the current one
+func Files(path string) ([]string, error) {
+	var files []string
+	for i := 1; ; i++ {
+		if _, err := os.Stat(rotated(path, i)); err != nil {
+			break
+		}
+		files = append(files, rotated(path, i))
+	}
+	slices.Reverse(files)
+
+	if _, err := os.Stat(path); err == nil {
+		files = append(files, path)
+	} else if len(files) == 0 {
+		return nil, fmt.Errorf("failed to open event log: %w", err)
+	}
+
+	return files, nil
+}
+
+// Read decodes records one by one and passes them to fn. Empty lines are skipped
+func Read(r io.Reader, fn func(*Record) error) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64<<10), maxLineSize)
+
+	for line := 1; sc.Scan(); line++ {
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+
+		rec := &Record{}
+		if err := json.Unmarshal(sc.Bytes(), rec); err != nil {
+			return fmt.Errorf("event log line %d: %w", line, err)
+		}
+		if rec.Version > SchemaVersion {
+			return fmt.Errorf("event log line %d: unsupported schema version %d", line, rec.Version)
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+
+	return sc.Err()
+}
+
+// ReadFiles reads records of event log, rotated files included, in order of writing
+func ReadFiles(path string, fn func(*Record) error) error {
+	files, err := Files(path)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := readFile(file, fn); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+func readFile(path string, fn func(*Record) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return Read(f, fn)
+}
+
+// LastSeq returns sequence number of the latest record of event log, rotated files included.
+// Returns zero if there is no event log or it has no records yet
+func LastSeq(path string) (uint64, error) {
+	files, err := Files(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	for _, file := range slices.Backward(files) {
+		seq, ok, err := lastSeqOf(file)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", file, err)
+		}
+		if ok {
+			return seq, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// lastSeqOf returns sequence number of the latest complete record of file. Returns false if file has no records
+func lastSeqOf(path string) (uint64, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, false, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, false, err
+	}
+
+	// The latest record is inside the tail of the max line's size, a line torn by crash precedes it
+	offset := max(info.Size()-2*maxLineSize, 0)
+	tail := make([]byte, info.Size()-offset)
+	if _, err := f.ReadAt(tail, offset); err != nil && !errors.Is(err, io.EOF) {
+		return 0, false, err
+	}
+
+	lines := bytes.Split(tail, []byte{'\n'})
+	for i := len(lines) - 1; i >= 0; i-- {
+		if offset > 0 && i == 0 {
+			break // The first line of tail may be cut
+		}
+
+		var rec struct {
+			Seq uint64 `json:"seq"`
+		}
+		if len(lines[i]) == 0 || json.Unmarshal(lines[i], &rec) != nil {
+			continue
+		}
+
+		return rec.Seq, true, nil
+	}
+
+	return 0, false, nil
+}
@@ -0,0 +1,169 @@
+// Package eventlog keeps service events as JSON Lines of stable schema in size-rotated files
+package eventlog
+
+import (
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/entities"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// SchemaVersion is a version of records' schema. Fields are only added within a version
+const SchemaVersion = 1
+
+// Record is a logged service event
+type Record struct {
+	Version        int            `json:"v"`
+	Seq            uint64         `json:"seq"` // Seq is event's number, numbers increase by one starting from 1
+	Time           time.Time      `json:"time"`
+	Type           string         `json:"type"`
+	TeamId         *uint64        `json:"team_id,omitempty"` // TeamId is absent if event has happened to request only
+	TeamStatus     string         `json:"team_status,omitempty"`
+	AvailableTeams uint64         `json:"available_teams"`
+	Request        *RequestRecord `json:"request,omitempty"` // Request is absent if event has happened to team only
+}
+
+// RequestRecord is a state of request taken when event has happened. Times are in seconds
+type RequestRecord struct {
+	Id             uint64     `json:"id"`
+	ClientId       uint64     `json:"client_id"`
+	CleaningType   uint       `json:"cleaning_type"`
+	Priority       uint       `json:"priority"`
+	Status         string     `json:"status,omitempty"`  // Status is absent if request is submitted directly to team and is not assigned yet
+	TeamId         *uint64    `json:"team_id,omitempty"` // TeamId is absent until request is assigned or booked
+	SubmittedAt    time.Time  `json:"submitted_at"`
+	ScheduledAt    *time.Time `json:"scheduled_at,omitempty"`
+	Deadline       *time.Time `json:"deadline,omitempty"`
+	StartedAt      *time.Time `json:"started_at,omitempty"`
+	FinishedAt     *time.Time `json:"finished_at,omitempty"`
+	WaitTime       float64    `json:"wait_time"`
+	TimeInCleaner  float64    `json:"time_in_cleaner"`
+	TravelTime     float64    `json:"travel_time,omitempty"`
+	Stage          int        `json:"stage,omitempty"`
+	Reworks        uint       `json:"reworks,omitempty"`
+	Cost           float64    `json:"cost,omitempty"`
+	Revenue        float64    `json:"revenue,omitempty"`
+	SubscriptionId uint64     `json:"subscription_id,omitempty"`
+
+	Patience float64         `json:"patience,omitempty"` // Patience is absent if request waits in queue as long as it takes
+	Location *LocationRecord `json:"location,omitempty"`
+}
+
+// LocationRecord is a client's site
+type LocationRecord struct {
+	X    float64 `json:"x"`
+	Y    float64 `json:"y"`
+	Site uint64  `json:"site"`
+}
+
+// Stable names of events' types
+var typeNames = map[dto.EventType]string{
+	dto.EventRequestSubmitted:  "request_submitted",
+	dto.EventRequestAssigned:   "request_assigned",
+	dto.EventRequestStarted:    "request_started",
+	dto.EventRequestCompleted:  "request_completed",
+	dto.EventRequestRejected:   "request_rejected",
+	dto.EventRequestReneged:    "request_reneged",
+	dto.EventRequestBalked:     "request_balked",
+	dto.EventRequestFailed:     "request_failed",
+	dto.EventRequestReworked:   "request_reworked",
+	dto.EventRequestScheduled:  "request_scheduled",
+	dto.EventRequestCanceled:   "request_canceled",
+	dto.EventStageCompleted:    "stage_completed",
+	dto.EventTeamFailed:        "team_failed",
+	dto.EventTeamRepaired:      "team_repaired",
+	dto.EventTeamReturned:      "team_returned",
+	dto.EventTeamStatusChanged: "team_status_changed",
+	dto.EventShiftStarted:      "shift_started",
+	dto.EventShiftEnded:        "shift_ended",
+}
+
+// Stable names of requests' statuses
+var statusNames = map[dto.RequestStatus]string{
+	dto.RequestQueued:     "queued",
+	dto.RequestInProgress: "in_progress",
+	dto.RequestCompleted:  "completed",
+	dto.RequestRejected:   "rejected",
+	dto.RequestReneged:    "reneged",
+	dto.RequestBalked:     "balked",
+	dto.RequestFailed:     "failed",
+	dto.RequestScheduled:  "scheduled",
+	dto.RequestCanceled:   "canceled",
+}
+
+// Stable names of teams' statuses
+var teamStatusNames = map[entities.Status]string{
+	entities.Available:   "available",
+	entities.Busy:        "busy",
+	entities.Unavailable: "unavailable",
+	entities.OffShift:    "off_shift",
+}
+
+// TypeName returns stable name of event's type
+func TypeName(t dto.EventType) string {
+	return typeNames[t]
+}
+
+// TeamStatusName returns stable name of team's status
+func TeamStatusName(status uint) string {
+	return teamStatusNames[entities.Status(status)]
+}
+
+// NewRecord converts event to record of stable schema
+func NewRecord(e *dto.Event) *Record {
+	r := &Record{
+		Version:        SchemaVersion,
+		Seq:            e.Seq,
+		Time:           e.Time,
+		Type:           TypeName(e.Type),
+		TeamId:         e.TeamId,
+		AvailableTeams: e.AvailableTeams,
+	}
+	if e.TeamId != nil {
+		r.TeamStatus = TeamStatusName(e.TeamStatus)
+	}
+	if req := e.Request; req != nil {
+		r.Request = &RequestRecord{
+			Id:             req.Id,
+			ClientId:       req.ClientId,
+			CleaningType:   req.CleaningType,
+			Priority:       req.Priority,
+			Status:         statusNames[req.Status],
+			SubmittedAt:    req.SubmittedAt,
+			ScheduledAt:    optionalTime(req.ScheduledAt),
+			Deadline:       optionalTime(req.Deadline),
+			StartedAt:      optionalTime(req.StartedAt),
+			FinishedAt:     optionalTime(req.FinishedAt),
+			WaitTime:       req.WaitTime.Seconds(),
+			TimeInCleaner:  req.TimeInCleaner.Seconds(),
+			TravelTime:     req.TravelTime.Seconds(),
+			Stage:          req.Stage,
+			Reworks:        req.Reworks,
+			Cost:           req.Cost,
+			Revenue:        req.Revenue,
+			SubscriptionId: req.SubscriptionId,
+
+			Patience: req.Patience.Seconds(),
+		}
+		if loc := req.Location; loc != nil {
+			r.Request.Location = &LocationRecord{X: loc.X, Y: loc.Y, Site: loc.Site}
+		}
+
+		// Team is known since request has been assigned or booked
+		switch req.Status {
+		case dto.RequestInProgress, dto.RequestCompleted, dto.RequestScheduled:
+			teamId := req.TeamId
+			r.Request.TeamId = &teamId
+		}
+	}
+
+	return r
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
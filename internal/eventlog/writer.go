@@ -0,0 +1,108 @@
+package eventlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Writer appends records as JSON Lines to file. File, which grows over max size, is rotated:
+// it is renamed to path.1, the older ones are shifted to path.2 and so on, the oldest over max amount are removed.
+// The newest rotated file is kept anyway, so the latest sequence number survives restart even if new file is still empty
+type Writer struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+	f        *os.File // f is nil if file is not open, e.g. rotation has failed, it is opened again on the next write
+	size     int64
+}
+
+// NewWriter opens file at path for appending records. Writer keeps at most maxFiles rotated files, but at least one
+func NewWriter(path string, maxSize int64, maxFiles int) (*Writer, error) {
+	w := &Writer{path: path, maxSize: maxSize, maxFiles: maxFiles}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Write appends record to file rotating it beforehand if record does not fit
+func (w *Writer) Write(r *Record) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if w.f == nil {
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+	if w.size > 0 && w.size+int64(len(line)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := w.f.Write(line)
+	w.size += int64(n)
+
+	return err
+}
+
+// Close closes current file
+func (w *Writer) Close() error {
+	if w.f == nil {
+		return nil
+	}
+
+	f := w.f
+	w.f = nil
+	return f.Close()
+}
+
+func (w *Writer) open() error {
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open event log: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to open event log: %w", err)
+	}
+
+	w.f, w.size = f, info.Size()
+	return nil
+}
+
+// rotate closes current file, shifts rotated ones and opens new one.
+// If rotation fails, the next write opens current file again and retries rotation
+func (w *Writer) rotate() error {
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	keep := max(w.maxFiles, 1)
+	if err := os.Remove(rotated(w.path, keep)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := keep - 1; i > 0; i-- {
+		if err := os.Rename(rotated(w.path, i), rotated(w.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(w.path, rotated(w.path, 1)); err != nil {
+		return err
+	}
+
+	return w.open()
+}
+
+// rotated returns path of i-th rotated file, the greater i the older file is
+func rotated(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
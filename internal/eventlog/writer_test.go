@@ -0,0 +1,153 @@
+package eventlog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func write(t *testing.T, w *Writer, from, to uint64) {
+	t.Helper()
+
+	for seq := from; seq <= to; seq++ {
+		if err := w.Write(&Record{Version: SchemaVersion, Seq: seq, Time: time.Unix(int64(seq), 0), Type: "request_submitted"}); err != nil {
+			t.Fatalf("write %d: %v", seq, err)
+		}
+	}
+}
+
+func lastSeq(t *testing.T, path string) uint64 {
+	t.Helper()
+
+	seq, err := LastSeq(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return seq
+}
+
+func TestLastSeq(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	if seq := lastSeq(t, path); seq != 0 {
+		t.Fatalf("last seq of missing log = %d, want 0", seq)
+	}
+
+	w, err := NewWriter(path, 1<<20, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	write(t, w, 1, 10)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if seq := lastSeq(t, path); seq != 10 {
+		t.Fatalf("last seq = %d, want 10", seq)
+	}
+
+	// Line torn by crash is not a record
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`{"v":1,"seq":11,"ti`)
+	_ = f.Close()
+	if seq := lastSeq(t, path); seq != 10 {
+		t.Fatalf("last seq after torn line = %d, want 10", seq)
+	}
+}
+
+func TestLastSeqOfRotatedLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	w, err := NewWriter(path, 300, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	write(t, w, 1, 20)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if seq := lastSeq(t, path); seq != 20 {
+		t.Fatalf("last seq = %d, want 20", seq)
+	}
+
+	// Current file is empty right after rotation, the latest record is in rotated one
+	if err := os.Truncate(path, 0); err != nil {
+		t.Fatal(err)
+	}
+	seq := lastSeq(t, path)
+	if seq == 0 || seq >= 20 {
+		t.Fatalf("last seq of rotated file = %d, want one of earlier records", seq)
+	}
+
+	var prev uint64
+	if err := ReadFiles(path, func(r *Record) error {
+		if r.Seq <= prev {
+			t.Fatalf("seq %d follows %d", r.Seq, prev)
+		}
+		prev = r.Seq
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLastSeqSurvivesRotationWithoutRotatedFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	w, err := NewWriter(path, 300, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	write(t, w, 1, 20)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The newest rotated file is kept, so numbering continues even if service has stopped right after rotation
+	if err := os.Truncate(path, 0); err != nil {
+		t.Fatal(err)
+	}
+	if seq := lastSeq(t, path); seq == 0 || seq >= 20 {
+		t.Fatalf("last seq = %d, want one of earlier records", seq)
+	}
+	if _, err := os.Stat(rotated(path, 2)); !os.IsNotExist(err) {
+		t.Fatalf("older rotated file is kept: %v", err)
+	}
+}
+
+func TestWriterRecoversFromFailedRotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.jsonl")
+	w, err := NewWriter(path, 300, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	// Directory in place of rotated file makes renaming fail
+	if err := os.Mkdir(rotated(path, 1), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(rotated(path, 1), "file"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	failed := false
+	for seq := uint64(1); seq <= 20 && !failed; seq++ {
+		failed = w.Write(&Record{Version: SchemaVersion, Seq: seq, Type: "request_submitted"}) != nil
+	}
+	if !failed {
+		t.Fatal("rotation over directory succeeded")
+	}
+
+	if err := os.RemoveAll(rotated(path, 1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(&Record{Version: SchemaVersion, Seq: 100, Type: "request_submitted"}); err != nil {
+		t.Fatalf("write after failed rotation: %v", err)
+	}
+	if seq := lastSeq(t, path); seq != 100 {
+		t.Fatalf("last seq = %d, want 100", seq)
+	}
+}
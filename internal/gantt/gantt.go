@@ -0,0 +1,240 @@
+// Package gantt renders teams' activity timeline as Gantt chart in formats, which plot directly
+package gantt
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// Formats of chart
+const (
+	FormatCSV   = "csv"   // FormatCSV is a table of intervals
+	FormatTrace = "trace" // FormatTrace is Chrome trace-event JSON, which Perfetto and chrome://tracing open
+	FormatSVG   = "svg"
+	FormatHTML  = "html" // FormatHTML is a self-contained page with SVG chart
+)
+
+// Interval is a bar of chart
+type Interval struct {
+	TeamId    uint64
+	RequestId uint64 // RequestId is zero if activity is not related to request
+	Kind      string // Kind is a name of activity, see KindName
+	Start     time.Time
+	End       time.Time
+}
+
+// Names of activities
+var kindNames = map[dto.ActivityKind]string{
+	dto.ActivityTravel:   "travel",
+	dto.ActivityCleaning: "cleaning",
+	dto.ActivityReturn:   "return",
+	dto.ActivityRepair:   "repair",
+}
+
+// KindName returns name of activity's kind
+func KindName(k dto.ActivityKind) string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// FromTimeline converts service's timeline to chart's intervals
+func FromTimeline(timeline []*dto.Interval) []Interval {
+	intervals := make([]Interval, 0, len(timeline))
+	for _, iv := range timeline {
+		intervals = append(intervals, Interval{
+			TeamId:    iv.TeamId,
+			RequestId: iv.RequestId,
+			Kind:      KindName(iv.Kind),
+			Start:     iv.Start,
+			End:       iv.End,
+		})
+	}
+
+	return intervals
+}
+
+// FormatOf returns format of chart file by its extension: .csv, .json for trace events, .svg, .html or .htm
+func FormatOf(path string) (string, error) {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		return FormatCSV, nil
+	case ".json":
+		return FormatTrace, nil
+	case ".svg":
+		return FormatSVG, nil
+	case ".html", ".htm":
+		return FormatHTML, nil
+	default:
+		return "", fmt.Errorf("unknown chart format of file %q", path)
+	}
+}
+
+// Write renders intervals in given format
+func Write(w io.Writer, format string, intervals []Interval) error {
+	switch format {
+	case FormatCSV:
+		return WriteCSV(w, intervals)
+	case FormatTrace:
+		return WriteTrace(w, intervals)
+	case FormatSVG:
+		return WriteSVG(w, intervals)
+	case FormatHTML:
+		return WriteHTML(w, intervals)
+	default:
+		return fmt.Errorf("unknown chart format %q", format)
+	}
+}
+
+// WriteCSV writes intervals as "team_id,request_id,kind,start,end,duration" rows, duration is in seconds.
+// Request id is empty if activity is not related to request
+func WriteCSV(w io.Writer, intervals []Interval) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"team_id", "request_id", "kind", "start", "end", "duration"})
+	for _, iv := range intervals {
+		requestId := ""
+		if iv.RequestId != 0 {
+			requestId = strconv.FormatUint(iv.RequestId, 10)
+		}
+		_ = cw.Write([]string{
+			strconv.FormatUint(iv.TeamId, 10),
+			requestId,
+			iv.Kind,
+			iv.Start.Format(time.RFC3339Nano),
+			iv.End.Format(time.RFC3339Nano),
+			strconv.FormatFloat(iv.End.Sub(iv.Start).Seconds(), 'f', 6, 64),
+		})
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// traceEvent is an event of Chrome trace-event format, times are in microseconds
+type traceEvent struct {
+	Name     string         `json:"name"`
+	Category string         `json:"cat,omitempty"`
+	Phase    string         `json:"ph"`
+	Ts       float64        `json:"ts"`
+	Dur      float64        `json:"dur,omitempty"`
+	Pid      int            `json:"pid"`
+	Tid      int            `json:"tid"`
+	Args     map[string]any `json:"args,omitempty"`
+}
+
+// WriteTrace writes intervals as complete events of Chrome trace-event format. Every team's slot is a thread,
+// times are counted from the earliest interval's start
+func WriteTrace(w io.Writer, intervals []Interval) error {
+	rows := layout(intervals)
+	origin := originOf(intervals)
+
+	events := []traceEvent{{Name: "process_name", Phase: "M", Pid: 1, Args: map[string]any{"name": "cleaner"}}}
+	for tid, row := range rows {
+		events = append(events,
+			traceEvent{Name: "thread_name", Phase: "M", Pid: 1, Tid: tid, Args: map[string]any{"name": row.label()}},
+			traceEvent{Name: "thread_sort_index", Phase: "M", Pid: 1, Tid: tid, Args: map[string]any{"sort_index": tid}},
+		)
+		for _, iv := range row.intervals {
+			e := traceEvent{
+				Name:     iv.Kind,
+				Category: iv.Kind,
+				Phase:    "X",
+				Ts:       micros(iv.Start.Sub(origin)),
+				Dur:      micros(iv.End.Sub(iv.Start)),
+				Pid:      1,
+				Tid:      tid,
+				Args:     map[string]any{"team_id": iv.TeamId},
+			}
+			if iv.RequestId != 0 {
+				e.Name = fmt.Sprintf("%s %d", iv.Kind, iv.RequestId)
+				e.Args["request_id"] = iv.RequestId
+			}
+			events = append(events, e)
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	return enc.Encode(map[string]any{
+		"traceEvents":     events,
+		"displayTimeUnit": "ms",
+		"otherData":       map[string]any{"origin": origin.Format(time.RFC3339Nano)},
+	})
+}
+
+func micros(d time.Duration) float64 {
+	return float64(d) / float64(time.Microsecond)
+}
+
+// row is a chart's row of team's slot, which intervals do not overlap
+type row struct {
+	teamId    uint64
+	slot      int
+	slots     int // slots is amount of team's rows
+	intervals []Interval
+}
+
+func (r *row) label() string {
+	if r.slots == 1 {
+		return fmt.Sprintf("team %d", r.teamId)
+	}
+
+	return fmt.Sprintf("team %d/%d", r.teamId, r.slot+1)
+}
+
+// layout places intervals on rows, team has as many rows as it has simultaneous activities at most
+func layout(intervals []Interval) []*row {
+	sorted := slices.Clone(intervals)
+	slices.SortStableFunc(sorted, func(a, b Interval) int {
+		return cmp.Or(cmp.Compare(a.TeamId, b.TeamId), a.Start.Compare(b.Start))
+	})
+
+	var rows []*row
+	for i := 0; i < len(sorted); {
+		teamId := sorted[i].TeamId
+		var team []*row
+		for ; i < len(sorted) && sorted[i].TeamId == teamId; i++ {
+			iv := sorted[i]
+			placed := false
+			for _, r := range team {
+				if last := r.intervals[len(r.intervals)-1]; !last.End.After(iv.Start) {
+					r.intervals = append(r.intervals, iv)
+					placed = true
+					break
+				}
+			}
+			if !placed {
+				team = append(team, &row{teamId: teamId, slot: len(team), intervals: []Interval{iv}})
+			}
+		}
+		for _, r := range team {
+			r.slots = len(team)
+		}
+		rows = append(rows, team...)
+	}
+
+	return rows
+}
+
+// originOf returns the earliest start of intervals
+func originOf(intervals []Interval) time.Time {
+	var origin time.Time
+	for _, iv := range intervals {
+		if origin.IsZero() || iv.Start.Before(origin) {
+			origin = iv.Start
+		}
+	}
+
+	return origin
+}
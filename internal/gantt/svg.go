@@ -0,0 +1,208 @@
+package gantt
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"slices"
+	"time"
+)
+
+// Sizes of SVG chart in pixels
+const (
+	labelWidth = 90
+	chartWidth = 1100
+	rowHeight  = 18
+	rowGap     = 4
+	axisHeight = 24
+	legendSize = 32
+)
+
+// colors are colors of activities' bars
+var colors = map[string]string{
+	"cleaning": "#4c78a8",
+	"travel":   "#f58518",
+	"return":   "#72b7b2",
+	"repair":   "#e45756",
+}
+
+// kindsOrder is an order of activities in legend and summary
+var kindsOrder = []string{"cleaning", "travel", "return", "repair"}
+
+// tickSteps are candidate steps between time axis' ticks
+var tickSteps = []time.Duration{
+	time.Second, 5 * time.Second, 10 * time.Second, 30 * time.Second,
+	time.Minute, 5 * time.Minute, 10 * time.Minute, 30 * time.Minute,
+	time.Hour, 2 * time.Hour, 6 * time.Hour, 12 * time.Hour,
+	24 * time.Hour, 7 * 24 * time.Hour, 30 * 24 * time.Hour,
+}
+
+// maxTicks limits amount of time axis' ticks
+const maxTicks = 12
+
+// WriteSVG writes intervals as SVG Gantt chart with a row per team's slot. Bars have tooltips with request ids
+func WriteSVG(w io.Writer, intervals []Interval) error {
+	bw := bufio.NewWriter(w)
+	writeSVG(bw, intervals)
+
+	return bw.Flush()
+}
+
+// WriteHTML writes self-contained page with SVG Gantt chart and teams' time spent on every activity
+func WriteHTML(w io.Writer, intervals []Interval) error {
+	bw := bufio.NewWriter(w)
+
+	from, to := bounds(intervals)
+	fmt.Fprint(bw, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Teams' timeline</title>
+<style>
+body { font-family: sans-serif; margin: 24px; }
+table { border-collapse: collapse; margin-top: 16px; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: right; }
+th:first-child, td:first-child { text-align: left; }
+</style>
+</head>
+<body>
+<h1>Teams' timeline</h1>
+`)
+	fmt.Fprintf(bw, "<p>%s &ndash; %s, %d intervals</p>\n",
+		from.Format(time.DateTime), to.Format(time.DateTime), len(intervals))
+	writeSVG(bw, intervals)
+	writeSummary(bw, intervals)
+	fmt.Fprint(bw, "</body>\n</html>\n")
+
+	return bw.Flush()
+}
+
+func writeSVG(w io.Writer, intervals []Interval) {
+	rows := layout(intervals)
+	from, to := bounds(intervals)
+	span := to.Sub(from)
+	if span <= 0 {
+		span = time.Second
+	}
+	x := func(t time.Time) float64 {
+		return labelWidth + float64(t.Sub(from))/float64(span)*chartWidth
+	}
+
+	width := labelWidth + chartWidth + 20
+	height := axisHeight + len(rows)*(rowHeight+rowGap) + legendSize
+	fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="sans-serif" font-size="11">`+"\n",
+		width, height)
+
+	// Time axis with grid lines
+	step := tickStep(span)
+	layoutOfTick := time.TimeOnly
+	if span > 24*time.Hour {
+		layoutOfTick = "Jan 2 15:04"
+	} else if step >= time.Minute {
+		layoutOfTick = "15:04"
+	}
+	gridBottom := axisHeight + len(rows)*(rowHeight+rowGap)
+	for t := from.Truncate(step); !t.After(to); t = t.Add(step) {
+		if t.Before(from) {
+			continue
+		}
+		fmt.Fprintf(w, `<line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="#ddd"/>`+"\n", x(t), axisHeight-4, x(t), gridBottom)
+		fmt.Fprintf(w, `<text x="%.1f" y="%d" text-anchor="middle" fill="#555">%s</text>`+"\n",
+			x(t), axisHeight-8, t.Format(layoutOfTick))
+	}
+
+	for i, r := range rows {
+		y := axisHeight + i*(rowHeight+rowGap)
+		if i%2 == 0 {
+			fmt.Fprintf(w, `<rect x="0" y="%d" width="%d" height="%d" fill="#f6f6f6"/>`+"\n", y-rowGap/2, width, rowHeight+rowGap)
+		}
+		fmt.Fprintf(w, `<text x="4" y="%d">%s</text>`+"\n", y+rowHeight-5, html.EscapeString(r.label()))
+
+		for _, iv := range r.intervals {
+			title := fmt.Sprintf("team %d, %s", iv.TeamId, iv.Kind)
+			if iv.RequestId != 0 {
+				title += fmt.Sprintf(" of request %d", iv.RequestId)
+			}
+			title += fmt.Sprintf(": %s - %s (%s)", iv.Start.Format(time.DateTime), iv.End.Format(time.DateTime),
+				iv.End.Sub(iv.Start).Round(time.Second))
+
+			fmt.Fprintf(w, `<rect x="%.2f" y="%d" width="%.2f" height="%d" fill="%s"><title>%s</title></rect>`+"\n",
+				x(iv.Start), y, max(x(iv.End)-x(iv.Start), 0.5), rowHeight, colorOf(iv.Kind), html.EscapeString(title))
+		}
+	}
+
+	// Legend of activities met on chart
+	lx := labelWidth
+	ly := gridBottom + legendSize/2
+	for _, kind := range kindsOrder {
+		if !slices.ContainsFunc(intervals, func(iv Interval) bool { return iv.Kind == kind }) {
+			continue
+		}
+		fmt.Fprintf(w, `<rect x="%d" y="%d" width="12" height="12" fill="%s"/>`+"\n", lx, ly-10, colorOf(kind))
+		fmt.Fprintf(w, `<text x="%d" y="%d">%s</text>`+"\n", lx+16, ly, kind)
+		lx += 100
+	}
+
+	fmt.Fprint(w, "</svg>\n")
+}
+
+// writeSummary writes table of time teams have spent on every activity
+func writeSummary(w io.Writer, intervals []Interval) {
+	spent := make(map[uint64]map[string]time.Duration)
+	var teams []uint64
+	for _, iv := range intervals {
+		if spent[iv.TeamId] == nil {
+			spent[iv.TeamId] = make(map[string]time.Duration)
+			teams = append(teams, iv.TeamId)
+		}
+		spent[iv.TeamId][iv.Kind] += iv.End.Sub(iv.Start)
+	}
+	slices.Sort(teams)
+
+	fmt.Fprint(w, "<table>\n<tr><th>Team</th>")
+	for _, kind := range kindsOrder {
+		fmt.Fprintf(w, "<th>%s</th>", kind)
+	}
+	fmt.Fprint(w, "</tr>\n")
+	for _, team := range teams {
+		fmt.Fprintf(w, "<tr><td>team %d</td>", team)
+		for _, kind := range kindsOrder {
+			fmt.Fprintf(w, "<td>%s</td>", spent[team][kind].Round(time.Second))
+		}
+		fmt.Fprint(w, "</tr>\n")
+	}
+	fmt.Fprint(w, "</table>\n")
+}
+
+// bounds returns the earliest start and the latest end of intervals
+func bounds(intervals []Interval) (time.Time, time.Time) {
+	from := originOf(intervals)
+	to := from
+	for _, iv := range intervals {
+		if iv.End.After(to) {
+			to = iv.End
+		}
+	}
+
+	return from, to
+}
+
+// tickStep returns the shortest step, which makes at most maxTicks ticks over span
+func tickStep(span time.Duration) time.Duration {
+	for _, step := range tickSteps {
+		if span/step <= maxTicks {
+			return step
+		}
+	}
+
+	return tickSteps[len(tickSteps)-1]
+}
+
+func colorOf(kind string) string {
+	if c, ok := colors[kind]; ok {
+		return c
+	}
+
+	return "#999"
+}
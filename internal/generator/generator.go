@@ -0,0 +1,78 @@
+// Package generator is an embedded source of cleaning requests, which makes cleaner able to run self-contained simulations
+package generator
+
+import (
+	"context"
+
+	"github.com/Bazhenator/cleaner/internal/clock"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	"github.com/Bazhenator/cleaner/internal/workload"
+	"github.com/Bazhenator/tools/src/logger"
+)
+
+// firstRequestId is an id of the first generated request. Generated ids are far from dispatcher's ones
+const firstRequestId = 1 << 48
+
+// Submitter accepts generated requests
+type Submitter interface {
+	SubmitCleaningRequest(context.Context, *dto.Request) (*dto.Request, error)
+}
+
+// Generator turns arrivals of workload source into cleaning requests
+type Generator struct {
+	l     *logger.Logger
+	clock clock.Clock
+
+	source    workload.Source
+	submitter Submitter
+	nextId    uint64
+}
+
+func NewGenerator(l *logger.Logger, clk clock.Clock, source workload.Source, submitter Submitter) *Generator {
+	return &Generator{
+		l:     l,
+		clock: clk,
+
+		source:    source,
+		submitter: submitter,
+		nextId:    firstRequestId,
+	}
+}
+
+// Start schedules arrivals on generator's clock until source is exhausted or ctx is done
+func (g *Generator) Start(ctx context.Context) {
+	arrival, ok := g.source.Next()
+	if !ok {
+		g.l.InfoCtx(ctx, "arrivals source is exhausted", logger.NewField("generated", g.nextId-firstRequestId))
+		return
+	}
+
+	g.clock.AfterFunc(arrival.Delay, func() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if _, err := g.submitter.SubmitCleaningRequest(ctx, g.newRequest(arrival)); err != nil {
+			g.l.WarnCtx(ctx, "generated request is not submitted", logger.NewErrorField(err))
+		}
+
+		g.Start(ctx)
+	})
+}
+
+func (g *Generator) newRequest(arrival *workload.Arrival) *dto.Request {
+	req := &dto.Request{
+		Id:           g.nextId,
+		ClientId:     arrival.ClientId,
+		CleaningType: arrival.CleaningType,
+		Priority:     arrival.Priority,
+		Patience:     arrival.Patience,
+		Location:     arrival.Location,
+	}
+	if arrival.Deadline > 0 {
+		req.Deadline = g.clock.Now().Add(arrival.Deadline)
+	}
+	g.nextId++
+
+	return req
+}
@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/clock"
+	"github.com/Bazhenator/cleaner/internal/entities"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	"github.com/Bazhenator/cleaner/internal/workload"
+)
+
+// cleaning is a cleaning in progress, which may be interrupted by team's breakdown
+type cleaning struct {
+	timer    clock.Timer
+	arrival  clock.Timer // arrival notifies that team has reached request, nil if team has not travelled
+	duration time.Duration
+	travel   time.Duration // travel is a time of team's way to request preceding cleaning
+
+	intervals []*dto.Interval // intervals are cleaning's travel and cleaning on timeline
+}
+
+// initBreakdowns starts failure processes of every team
+func (s *Service) initBreakdowns(c *configs.BreakdownsConfig) error {
+	var err error
+	s.failures, err = workload.NewDistribution(c.FailureDistribution, time.Duration(c.Mtbf*float64(time.Second)))
+	if err != nil {
+		return err
+	}
+	s.repairs, err = workload.NewDistribution(c.RepairDistribution, time.Duration(c.Mttr*float64(time.Second)))
+	if err != nil {
+		return err
+	}
+
+	for _, team := range s.teams {
+		s.scheduleFailure(team)
+	}
+
+	return nil
+}
+
+// scheduleFailure schedules team's next breakdown
+func (s *Service) scheduleFailure(team *entities.CleaningTeam) {
+	s.clock.AfterFunc(s.failures(s.rng), func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.failTeam(team)
+	})
+}
+
+// failTeam breaks team down, interrupts its cleanings and schedules repair. Must be called under s.mu
+func (s *Service) failTeam(team *entities.CleaningTeam) {
+	now := s.clock.Now()
+	s.cancelCleanings(team)
+	interrupted := team.Fail(now)
+	s.timeline.abort(team.Id, dto.ActivityReturn, now)
+	s.publish(dto.EventTeamFailed, team, nil)
+
+	for _, req := range interrupted {
+		req.Interruptions++
+		s.stats.interrupted++
+
+		s.interrupt(req)
+	}
+
+	repair := s.repairs(s.rng)
+	s.timeline.add(team.Id, 0, dto.ActivityRepair, now, now.Add(repair))
+	s.clock.AfterFunc(repair, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.repairTeam(team)
+	})
+}
+
+// interrupt handles request interrupted by team's breakdown according to breakdowns policy. Must be called under s.mu
+func (s *Service) interrupt(req *dto.Request) {
+	if s.c.Breakdowns.Policy == configs.BreakdownFail {
+		s.stats.failed++
+		s.finish(req, dto.RequestFailed)
+		s.publish(dto.EventRequestFailed, nil, req)
+		return
+	}
+
+	s.requeue(req)
+}
+
+// repairTeam makes team available and schedules its next breakdown. Must be called under s.mu
+func (s *Service) repairTeam(team *entities.CleaningTeam) {
+	team.Repair(s.clock.Now())
+	s.publish(dto.EventTeamRepaired, team, nil)
+
+	s.dispatch()
+	s.scheduleFailure(team)
+}
+
+// availability returns share of time team has been operational since statistics are collected
+func availability(team *entities.CleaningTeam, now time.Time) float64 {
+	elapsed := now.Sub(team.StatsSince)
+	if elapsed <= 0 {
+		return 1
+	}
+
+	return 1 - (team.TotalDownTime+team.DownTimeSince(now)).Seconds()/elapsed.Seconds()
+}
@@ -0,0 +1,187 @@
+package logic
+
+import (
+	"context"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/clock"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	"github.com/Bazhenator/tools/src/logger"
+)
+
+const testMttr = 100 * time.Second
+
+// breakdownsConfig makes teams fail only when test breaks them down and get repaired in testMttr
+func breakdownsConfig(policy string) *configs.BreakdownsConfig {
+	return &configs.BreakdownsConfig{
+		Mtbf:                1e6,
+		Mttr:                testMttr.Seconds(),
+		FailureDistribution: configs.DistributionDeterministic,
+		RepairDistribution:  configs.DistributionDeterministic,
+		Policy:              policy,
+	}
+}
+
+func failTeam(s *Service, teamId uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failTeam(s.teams[teamId])
+}
+
+func TestBreakdownInterruptsCleaning(t *testing.T) {
+	tests := []struct {
+		policy string
+		status dto.RequestStatus
+	}{
+		{configs.BreakdownRequeue, dto.RequestQueued},
+		{configs.BreakdownFail, dto.RequestFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			s, clk := newTestService(t, &configs.Config{TeamsAmount: 1, Breakdowns: breakdownsConfig(tt.policy)})
+			if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: 1}); err != nil {
+				t.Fatal(err)
+			}
+			d := requestOf(t, s, 1).TimeInCleaner
+
+			clk.RunUntil(testStart.Add(d / 2))
+			failTeam(s, 0)
+
+			// Request is charged only for a half of cleaning done before breakdown
+			req := requestOf(t, s, 1)
+			if req.Status != tt.status || req.TimeInCleaner != d/2 || req.Interruptions != 1 {
+				t.Fatalf("status = %d, time in cleaner = %s, interruptions = %d, want %d, %s and 1",
+					req.Status, req.TimeInCleaner, req.Interruptions, tt.status, d/2)
+			}
+
+			// Completion of interrupted cleaning never comes
+			clk.RunUntil(testStart.Add(d))
+			if req := requestOf(t, s, 1); req.Status != tt.status {
+				t.Fatalf("status after interrupted cleaning's end = %d, want %d", req.Status, tt.status)
+			}
+		})
+	}
+}
+
+func TestRepairedTeamResumesRequeuedRequest(t *testing.T) {
+	s, clk := newTestService(t, &configs.Config{TeamsAmount: 1, Breakdowns: breakdownsConfig(configs.BreakdownRequeue)})
+	if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: 1}); err != nil {
+		t.Fatal(err)
+	}
+	d := requestOf(t, s, 1).TimeInCleaner
+
+	clk.RunUntil(testStart.Add(d / 2))
+	failTeam(s, 0)
+	clk.RunUntil(testStart.Add(d/2 + testMttr))
+
+	req := requestOf(t, s, 1)
+	if req.Status != dto.RequestInProgress || req.WaitTime != testMttr {
+		t.Fatalf("status = %d, wait time = %s, want %d and %s", req.Status, req.WaitTime, dto.RequestInProgress, testMttr)
+	}
+
+	// The whole cleaning is done anew after repair
+	resumed := req.TimeInCleaner - d/2
+	finished := testStart.Add(d/2 + testMttr + resumed)
+	clk.RunUntil(finished)
+
+	req = requestOf(t, s, 1)
+	if req.Status != dto.RequestCompleted || !req.FinishedAt.Equal(finished) {
+		t.Fatalf("status = %d, finished at %s, want %d at %s", req.Status, req.FinishedAt, dto.RequestCompleted, finished)
+	}
+
+	out, err := s.GetTeamsStats(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	team := out.Stats[0]
+	if team.Failures != 1 || team.TotalDownTime != testMttr || team.ProcessedRequests != 1 {
+		t.Fatalf("failures = %d, down time = %s, processed = %d, want 1, %s and 1",
+			team.Failures, team.TotalDownTime, team.ProcessedRequests, testMttr)
+	}
+	if want := 1 - testMttr.Seconds()/finished.Sub(testStart).Seconds(); team.Availability != want {
+		t.Fatalf("availability = %f, want %f", team.Availability, want)
+	}
+}
+
+// lateClock is a virtual clock, which timers can't be stopped, as if they had already fired
+// and their calls were waiting for service's lock
+type lateClock struct {
+	*clock.Virtual
+}
+
+func (c lateClock) AfterFunc(d time.Duration, f func()) clock.Timer {
+	c.Virtual.AfterFunc(d, f)
+	return lateTimer{}
+}
+
+type lateTimer struct{}
+
+func (lateTimer) Stop() bool {
+	return false
+}
+
+// newLateTestService is newTestService, which timers fire even if they have been stopped
+func newLateTestService(t *testing.T, c *configs.Config) (*Service, *clock.Virtual) {
+	t.Helper()
+
+	clk := clock.NewVirtual(testStart)
+	c.BaseSpeed, c.AssignPolicy = 60, configs.DefAssignPolicy
+	s, err := NewService(c, &logger.Logger{Logger: zap.NewNop()}, WithClock(lateClock{clk}), WithRand(rand.New(rand.NewPCG(1, 2))))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s, clk
+}
+
+func TestLateCompletionOfInterruptedCleaningIsIgnored(t *testing.T) {
+	s, clk := newLateTestService(t, &configs.Config{TeamsAmount: 1, Breakdowns: breakdownsConfig(configs.BreakdownRequeue)})
+	if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: 1}); err != nil {
+		t.Fatal(err)
+	}
+	d := requestOf(t, s, 1).TimeInCleaner
+
+	clk.RunUntil(testStart.Add(d / 2))
+	failTeam(s, 0)
+	clk.RunUntil(testStart.Add(d))
+
+	req := requestOf(t, s, 1)
+	if req.Status != dto.RequestQueued {
+		t.Fatalf("status = %d, want interrupted request to stay queued", req.Status)
+	}
+	if st := statsOf(t, s); st.Completed != 0 {
+		t.Fatalf("completed = %d, want 0", st.Completed)
+	}
+}
+
+func TestTeamBrokenDownOnTheWayNeverArrives(t *testing.T) {
+	s, clk := newLateTestService(t, &configs.Config{
+		TeamsAmount: 1,
+		Breakdowns:  breakdownsConfig(configs.BreakdownRequeue),
+		Travel:      travelConfig(configs.TravelChain),
+	})
+	if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: 1, Location: testLocation}); err != nil {
+		t.Fatal(err)
+	}
+
+	clk.RunUntil(testStart.Add(testTrip / 2))
+	failTeam(s, 0)
+	clk.RunUntil(testStart.Add(testTrip))
+
+	out, err := s.GetEvents(context.Background(), &dto.GetEventsIn{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range out.Events {
+		if e.Type == dto.EventRequestStarted {
+			t.Fatalf("request has started at %s after team's breakdown", e.Time)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// limiter is a token bucket limiting rate of client's requests
+type limiter struct {
+	tokens  float64
+	updated time.Time
+}
+
+// throttle rejects request, which exceeds rate of its client's requests. Must be called under s.mu
+func (s *Service) throttle(req *dto.Request) error {
+	c := s.c.Clients
+	if c == nil || c.Rate <= 0 {
+		return nil
+	}
+
+	now := s.clock.Now()
+	burst := float64(max(c.Burst, 1))
+	l, ok := s.limiters[req.ClientId]
+	if !ok {
+		l = &limiter{tokens: burst, updated: now}
+		s.limiters[req.ClientId] = l
+	}
+
+	l.tokens = min(burst, l.tokens+now.Sub(l.updated).Seconds()*c.Rate)
+	l.updated = now
+	if l.tokens < 1 {
+		s.reject(req)
+		return fmt.Errorf("%w: client %d is limited to %g requests per second", ErrRateLimited, req.ClientId, c.Rate)
+	}
+	l.tokens--
+
+	return nil
+}
+
+// GetClientStats gets statistics of clients' requests: arrivals, outcomes, waiting and cleaning times.
+// Returns statistics of the client if it is given, of every client known since statistics reset otherwise
+func (s *Service) GetClientStats(ctx context.Context, in *dto.GetClientStatsIn) (*dto.GetClientStatsOut, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ids []uint64
+	if in.ClientId != nil {
+		ids = []uint64{*in.ClientId}
+	} else {
+		known := maps.Clone(s.stats.clients)
+		for id := range s.queue.clients {
+			known[id] = nil
+		}
+		ids = slices.Sorted(maps.Keys(known))
+	}
+
+	out := &dto.GetClientStatsOut{Stats: make([]*dto.ClientStats, 0, len(ids))}
+	for _, id := range ids {
+		cs, ok := s.stats.clients[id]
+		if !ok {
+			cs = &clientStats{}
+		}
+
+		stats := &dto.ClientStats{
+			ClientId:  id,
+			Weight:    s.weight(id),
+			Arrivals:  cs.arrivals,
+			Completed: cs.completed,
+			Rejected:  cs.rejected,
+			Reneged:   cs.reneged,
+			Waiting:   s.queue.countOf(id),
+		}
+		if cs.completed > 0 {
+			stats.MeanWaitTime = cs.totalWait / time.Duration(cs.completed)
+			stats.MeanCleaningTime = cs.totalCleaning / time.Duration(cs.completed)
+			stats.MeanResponseTime = cs.totalResponse / time.Duration(cs.completed)
+		}
+		out.Stats = append(out.Stats, stats)
+	}
+
+	return out, nil
+}
+
+// weight returns client's share of teams under fair queueing
+func (s *Service) weight(clientId uint64) float64 {
+	if c := s.c.Clients; c != nil {
+		if weight, ok := c.Weights[clientId]; ok {
+			return weight
+		}
+	}
+
+	return 1
+}
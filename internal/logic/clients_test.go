@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+func TestClientsLimits(t *testing.T) {
+	type submission struct {
+		after    time.Duration // after is a time since testStart request is submitted at
+		clientId uint64
+		err      error
+	}
+	tests := []struct {
+		name        string
+		clients     *configs.ClientsConfig
+		submissions []submission
+	}{
+		{
+			name:    "quota of waiting requests",
+			clients: &configs.ClientsConfig{MaxWaiting: 1},
+			submissions: []submission{
+				{clientId: 1}, {clientId: 1}, {clientId: 1, err: ErrQuotaExceeded}, {clientId: 2},
+			},
+		},
+		{
+			name:    "rate with burst",
+			clients: &configs.ClientsConfig{Rate: 1, Burst: 2},
+			submissions: []submission{
+				{clientId: 1}, {clientId: 1}, {clientId: 1, err: ErrRateLimited}, {clientId: 2},
+				{after: time.Second, clientId: 1}, {after: time.Second, clientId: 1, err: ErrRateLimited},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, clk := newTestService(t, &configs.Config{TeamsAmount: 1, Clients: tt.clients})
+
+			var rejected uint64
+			for i, sub := range tt.submissions {
+				clk.RunUntil(testStart.Add(sub.after))
+				_, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: uint64(i + 1), ClientId: sub.clientId})
+				if sub.err != nil {
+					rejected++
+					if !errors.Is(err, sub.err) {
+						t.Fatalf("submission %d: error = %v, want %v", i, err, sub.err)
+					}
+				} else if err != nil {
+					t.Fatalf("submission %d: %v", i, err)
+				}
+			}
+
+			if st := statsOf(t, s); st.Rejected != rejected {
+				t.Fatalf("rejected = %d, want %d", st.Rejected, rejected)
+			}
+		})
+	}
+}
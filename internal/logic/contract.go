@@ -8,6 +8,21 @@ import (
 
 type CleanerService interface {
 	ProceedCleaningRequest(context.Context, *dto.ProceedCleaningRequestIn) (*dto.ProceedCleaningRequestOut, error)
-	GetAvailableTeams(context.Context) (*dto.GetAvailableTeamsOut, error)
+	SubmitCleaningRequest(context.Context, *dto.Request) (*dto.Request, error)
+	ScheduleCleaningRequest(context.Context, *dto.ScheduleCleaningRequestIn) (*dto.ScheduleCleaningRequestOut, error)
+	GetFreeSlots(context.Context, *dto.GetFreeSlotsIn) (*dto.GetFreeSlotsOut, error)
+	CreateSubscription(context.Context, *dto.Subscription) (*dto.Subscription, error)
+	GetSubscription(context.Context, uint64) (*dto.Subscription, error)
+	CancelSubscription(context.Context, uint64) (*dto.Subscription, error)
+	SkipOccurrence(context.Context, *dto.OccurrenceIn) (*dto.Occurrence, error)
+	RescheduleOccurrence(context.Context, *dto.OccurrenceIn) (*dto.Occurrence, error)
+	GetRequest(context.Context, uint64) (*dto.GetRequestOut, error)
+	GetAvailableTeams(context.Context, *dto.GetAvailableTeamsIn) (*dto.GetAvailableTeamsOut, error)
 	GetTeamsStats(context.Context) (*dto.GetTeamsStatsOut, error)
+	GetClientStats(context.Context, *dto.GetClientStatsIn) (*dto.GetClientStatsOut, error)
+	GetSystemStats(context.Context) (*dto.GetSystemStatsOut, error)
+	GetTheoreticalMetrics(context.Context, *dto.GetTheoreticalMetricsIn) (*dto.GetTheoreticalMetricsOut, error)
+	Subscribe(context.Context) <-chan *dto.Event
+	GetEvents(context.Context, *dto.GetEventsIn) (*dto.GetEventsOut, error)
+	GetTimeline(context.Context, *dto.GetTimelineIn) (*dto.GetTimelineOut, error)
 }
@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/entities"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// charge adds cost of team's work on request since its cleaning has started, overtime is paid at overtime rate.
+// Request pays for one of team's slots, slot's hour costs hourly cost divided by team's capacity, so requests of
+// fully loaded team together pay its hourly cost. Must be called under s.mu before team releases request
+func (s *Service) charge(team *entities.CleaningTeam, req *dto.Request, now time.Time) {
+	c := s.c.Costs
+	if c == nil {
+		return
+	}
+
+	busy := now.Sub(req.StartedAt)
+	var overtime time.Duration
+	if team.Overtime {
+		overtime = min(now.Sub(team.OvertimeStartedAt), busy)
+	}
+	slotCost := c.HourlyCosts[uint(team.Speed)] / float64(max(team.Capacity, 1))
+	req.Cost += slotCost * (busy - overtime + time.Duration(overtimeRate(c.OvertimeRate)*float64(overtime))).Hours()
+}
+
+// bill sets price of completed request and adds it to team's revenue. Must be called under s.mu
+func (s *Service) bill(team *entities.CleaningTeam, req *dto.Request) {
+	c := s.c.Costs
+	if c == nil {
+		return
+	}
+
+	// Client pays surcharge for priority it has asked for, rework's priority boost is free
+	priority := s.registry.payloads[req.Id].priority
+	req.Revenue = c.Prices[req.CleaningType] * (1 + c.Surcharges[priority])
+	team.Revenue += req.Revenue
+}
+
+// teamCost returns cost of team's paid time since statistics are collected: the whole shift, idle time included, and overtime.
+// Must be called under s.mu
+func (s *Service) teamCost(team *entities.CleaningTeam, now time.Time) float64 {
+	c := s.c.Costs
+	if c == nil {
+		return 0
+	}
+
+	shift := team.TotalShiftTime + team.ShiftTimeSince(now)
+	overtime := team.TotalOvertime + team.OvertimeSince(now)
+
+	return c.HourlyCosts[uint(team.Speed)] * (shift.Hours() + overtimeRate(c.OvertimeRate)*overtime.Hours())
+}
+
+// overtimeRate returns multiplier of hourly cost in overtime, 1 if it is not configured
+func overtimeRate(rate float64) float64 {
+	if rate == 0 {
+		return 1
+	}
+
+	return rate
+}
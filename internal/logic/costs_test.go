@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+const (
+	testHourlyCost = 30.
+	testPrice      = 50.
+)
+
+func costsConfig() *configs.CostsConfig {
+	return &configs.CostsConfig{
+		HourlyCosts: map[uint]float64{1: testHourlyCost, 2: testHourlyCost, 3: testHourlyCost},
+		Prices:      map[uint]float64{0: testPrice},
+		Surcharges:  map[uint]float64{1: 0.5},
+	}
+}
+
+func TestRequestIsChargedForItsSlot(t *testing.T) {
+	s, clk := newTestService(t, &configs.Config{TeamsAmount: 1, TeamsCapacities: []uint64{2}, Costs: costsConfig()})
+	for _, in := range []*dto.Request{{Id: 1}, {Id: 2, Priority: 1}} {
+		if _, err := s.SubmitCleaningRequest(context.Background(), in); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d1, d2 := requestOf(t, s, 1).TimeInCleaner, requestOf(t, s, 2).TimeInCleaner
+	clk.RunUntil(testStart.Add(max(d1, d2)))
+
+	// Requests cleaned at once by a team of two slots pay a half of team's hour each
+	for _, tt := range []struct {
+		id      uint64
+		cost    float64
+		revenue float64
+	}{
+		{1, testHourlyCost / 2 * d1.Hours(), testPrice},
+		{2, testHourlyCost / 2 * d2.Hours(), testPrice * 1.5},
+	} {
+		req := requestOf(t, s, tt.id)
+		if req.Status != dto.RequestCompleted || req.Cost != tt.cost || req.Revenue != tt.revenue {
+			t.Fatalf("request %d: status = %d, cost = %f, revenue = %f, want %d, %f and %f",
+				tt.id, req.Status, req.Cost, req.Revenue, dto.RequestCompleted, tt.cost, tt.revenue)
+		}
+	}
+
+	if team := teamStatsOf(t, s, 0); team.Revenue != 2.5*testPrice {
+		t.Fatalf("team's revenue = %f, want %f", team.Revenue, 2.5*testPrice)
+	}
+}
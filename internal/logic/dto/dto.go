@@ -2,6 +2,23 @@ package dto
 
 import (
 	"time"
+
+	"github.com/Bazhenator/cleaner/pkg/queueing"
+)
+
+// RequestStatus describes request's progress through cleaning service
+type RequestStatus uint
+
+const (
+	RequestQueued RequestStatus = iota + 1
+	RequestInProgress
+	RequestCompleted
+	RequestRejected
+	RequestReneged   // RequestReneged is a request, which has left the queue having run out of patience
+	RequestBalked    // RequestBalked is a request, which has not joined the queue being too long
+	RequestFailed    // RequestFailed is a request, which cleaning has been interrupted by team's breakdown
+	RequestScheduled // RequestScheduled is a request waiting for its booked start
+	RequestCanceled  // RequestCanceled is a scheduled request, which has been canceled before its start
 )
 
 type Request struct {
@@ -10,6 +27,72 @@ type Request struct {
 	TeamId        uint64
 	CleaningType  uint
 	Priority      uint
+	Patience      time.Duration // Patience is a max time request waits in queue, zero means unlimited
+	Deadline      time.Time     // Deadline is a moment cleaning must start before, zero means no deadline
+	Status        RequestStatus
+	TimeInCleaner time.Duration
+	SubmittedAt   time.Time
+	EnqueuedAt    time.Time     // EnqueuedAt is a moment request has joined the queue last time
+	StartedAt     time.Time     // StartedAt is a moment the last cleaning of request has started at
+	WaitTime      time.Duration // WaitTime is a total time spent in queue
+	Interruptions uint          // Interruptions is an amount of times cleaning has been interrupted by team's breakdown
+	FinishedAt    time.Time     // FinishedAt is a moment request has been completed or has left the service
+	Stage         int           // Stage is an index of request's current pipeline stage
+	Reworks       uint          // Reworks is an amount of times request has failed inspection
+	Pinned        bool          // Pinned request is reworked only by team TeamId
+	Location      *Location     // Location is a client's site, team does not travel to request without location
+	TravelTime    time.Duration // TravelTime is a time teams have spent on the way to request
+	ScheduledAt   time.Time     // ScheduledAt is a booked start of scheduled request, zero if request is not scheduled
+	Stages        []StageTime   // Stages are wait and cleaning times of request's pipeline stages, nil if its type has no pipeline
+
+	SubscriptionId uint64 // SubscriptionId is a subscription, which occurrence request is, zero if request is not recurring
+
+	Cost    float64 // Cost is a cost of teams' work on request, overtime included. Request pays for a team's slot
+	Revenue float64 // Revenue is a price client has paid for completed request
+}
+
+// Subscription is a client's recurring cleaning
+type Subscription struct {
+	Id           uint64
+	ClientId     uint64
+	CleaningType uint
+	Priority     uint
+	Rule         string        // Rule is an RRULE-style recurrence, e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO"
+	Start        time.Time     // Start is the first occurrence's start, the rest repeat it by rule
+	Window       time.Duration // Window is how late occurrence's cleaning may start
+	Location     *Location
+	SameTeam     bool // SameTeam makes occurrences prefer the team of the previous one
+	Canceled     bool
+	Occurrences  []*Occurrence // Occurrences are booked, skipped and rescheduled occurrences in order of number
+}
+
+// Occurrence is a single cleaning of subscription
+type Occurrence struct {
+	Number  uint64
+	Start   time.Time
+	Skipped bool
+	Request *Request // Request is nil until occurrence is booked
+}
+
+// OccurrenceIn identifies subscription's occurrence
+type OccurrenceIn struct {
+	SubscriptionId uint64
+	Number         uint64
+	Start          time.Time // Start is a new start of rescheduled occurrence
+}
+
+// Location is a point on plane or a site of distance matrix
+type Location struct {
+	X    float64
+	Y    float64
+	Site uint64 // Site is an index of location in distance matrix
+}
+
+// StageTime is a part of request's time spent on pipeline stage
+type StageTime struct {
+	Name          string
+	TeamId        uint64 // TeamId is a team, which has cleaned the stage last time
+	WaitTime      time.Duration
 	TimeInCleaner time.Duration
 }
 
@@ -22,17 +105,228 @@ type ProceedCleaningRequestOut struct {
 	Req *Request
 }
 
+type GetRequestOut struct {
+	Req *Request
+}
+
+type ScheduleCleaningRequestIn struct {
+	Request       *Request
+	EarliestStart time.Time // EarliestStart is the earliest moment cleaning may start at, now if it is in the past
+	LatestStart   time.Time // LatestStart is the latest moment cleaning may start at
+}
+
+type ScheduleCleaningRequestOut struct {
+	Req *Request // Req has booked team and start
+}
+
+type GetFreeSlotsIn struct {
+	CleaningType uint
+	From         time.Time
+	To           time.Time
+}
+
+type GetFreeSlotsOut struct {
+	Slots []*FreeSlot
+}
+
+// FreeSlot is a team's free interval, cleaning may be booked to start in it till End - Duration
+type FreeSlot struct {
+	TeamId   uint64
+	Start    time.Time
+	End      time.Time
+	Duration time.Duration // Duration is a time team's cleaning of the type is booked for
+}
+
+type GetAvailableTeamsIn struct {
+	CleaningType *uint // CleaningType filters teams able to clean it, all available teams are returned if nil
+}
+
 type GetAvailableTeamsOut struct {
-	Teams []uint64
+	Teams     []uint64
+	FreeSlots []uint64 // FreeSlots are amounts of requests teams are able to take now, in order of Teams
 }
 
 type TeamStats struct {
-	Id                uint64
-	Speed             uint32
-	ProcessedRequests uint64
-	TotalBusyTime     time.Duration
+	Id                 uint64
+	Speed              uint32
+	Skills             map[uint]float64
+	Status             uint32
+	Capacity           uint64
+	FreeSlots          uint64
+	ActiveRequests     []uint64 // ActiveRequests are ids of requests being cleaned
+	ProcessedRequests  uint64
+	TotalBusyTime      time.Duration
+	Utilization        float64 // Utilization is a share of slots' time they have been busy
+	Failures           uint64
+	TotalDownTime      time.Duration
+	Availability       float64 // Availability is a share of time team has not been broken
+	OnShift            bool
+	ShiftTime          time.Duration
+	OnShiftUtilization float64 // OnShiftUtilization is a share of shift time team has been busy, overtime excluded
+	Overtime           time.Duration
+	Handoffs           uint64
+	Reworks            uint64        // Reworks is an amount of team's cleanings, which have failed inspection
+	ReworkRate         float64       // ReworkRate is a share of team's cleanings, which have failed inspection
+	TravelTime         time.Duration // TravelTime is a part of busy time team has spent on the way
+	Position           Location
+	Cost               float64 // Cost is a cost of team's paid time, idle one included
+	Revenue            float64 // Revenue is a sum of prices of requests completed by team
 }
 
 type GetTeamsStatsOut struct {
 	Stats []*TeamStats
-}
\ No newline at end of file
+}
+
+type GetClientStatsIn struct {
+	ClientId *uint64 // ClientId is nil to get statistics of every known client
+}
+
+type GetClientStatsOut struct {
+	Stats []*ClientStats
+}
+
+// ClientStats are statistics of client's requests
+type ClientStats struct {
+	ClientId         uint64
+	Weight           float64 // Weight is a client's share of teams under fair queueing
+	Arrivals         uint64
+	Completed        uint64
+	Rejected         uint64 // Rejected is an amount of requests rejected, balked or rate limited
+	Reneged          uint64
+	Waiting          uint64 // Waiting is an amount of client's requests in queue now
+	MeanWaitTime     time.Duration
+	MeanCleaningTime time.Duration
+	MeanResponseTime time.Duration
+}
+
+type GetSystemStatsOut struct {
+	Since              time.Time
+	Elapsed            time.Duration
+	Arrivals           uint64
+	Started            uint64
+	Completed          uint64
+	Rejected           uint64
+	Reneged            uint64
+	Balked             uint64
+	Scheduled          uint64
+	Canceled           uint64
+	Interrupted        uint64
+	Failed             uint64
+	Handoffs           uint64
+	Reworked           uint64
+	ReworkRate         float64       // ReworkRate is a share of inspections failed by completed requests
+	TravelTime         time.Duration // TravelTime is a total travel time of teams
+	MeanTravelTime     time.Duration // MeanTravelTime is a mean time of travel to started requests
+	Overtime           time.Duration // Overtime is a total overtime of teams
+	QueueLength        uint64
+	MaxQueueLength     uint64
+	MeanQueueLength    float64
+	MeanWaitTime       time.Duration
+	MeanResponseTime   time.Duration
+	Throughput         float64 // Throughput is amount of completed requests per second
+	Utilization        float64 // Utilization is a mean utilization of teams
+	Availability       float64 // Availability is a mean availability of teams
+	OnShiftUtilization float64 // OnShiftUtilization is a mean on-shift utilization of teams
+	Cost               float64 // Cost is a total cost of teams' paid time
+	Revenue            float64 // Revenue is a total price of completed requests
+	Profit             float64
+	Stages             []*StageStats
+}
+
+// StageStats are statistics of pipeline stages with the same name
+type StageStats struct {
+	Name              string
+	Completed         uint64
+	MeanWaitTime      time.Duration
+	MeanTimeInCleaner time.Duration
+}
+
+type GetTheoreticalMetricsIn struct {
+	ArrivalRate   float64 // ArrivalRate is taken from generator's configuration if zero
+	QueueCapacity int     // QueueCapacity is a queue size of M/M/c/K model, configured one is used if negative
+	ServiceScv    float64 // ServiceScv is a squared coefficient of variation of cleaning time for M/G/1 model
+}
+
+type GetTheoreticalMetricsOut struct {
+	ArrivalRate     float64
+	MeanServiceRate float64
+	Teams           uint64
+	Models          []*queueing.Model
+}
+
+// EventType describes what has happened inside cleaning service
+type EventType uint
+
+const (
+	EventRequestAssigned EventType = iota + 1
+	EventRequestCompleted
+	EventRequestReneged
+	EventRequestBalked
+	EventRequestFailed
+	EventTeamFailed
+	EventTeamRepaired
+	EventShiftStarted
+	EventShiftEnded
+	EventStageCompleted // EventStageCompleted is published when request moves to the next pipeline stage
+	EventRequestReworked
+	EventTeamReturned // EventTeamReturned is published when team's return trip to base ends
+	EventRequestScheduled
+	EventRequestCanceled
+	EventRequestSubmitted
+	EventRequestStarted // EventRequestStarted is published when team has reached request and starts cleaning
+	EventRequestRejected
+	EventTeamStatusChanged
+)
+
+type Event struct {
+	Seq            uint64 // Seq is event's number in service's event log, numbers increase by one starting from 1
+	Type           EventType
+	TeamId         *uint64 // TeamId is nil if event has happened to request only
+	RequestId      uint64
+	Request        *Request // Request is a snapshot of request taken when event has happened, nil if event has happened to team only
+	TeamStatus     uint
+	AvailableTeams uint64
+	Time           time.Time
+}
+
+type GetEventsIn struct {
+	Offset uint64 // Offset is a sequence number of the first event to get, the earliest retained event if zero
+	Wait   bool   // Wait blocks until there is an event since offset
+
+	Tail uint64 // Tail limits events to the latest ones if offset is zero, e.g. to catch up with recent history only
+}
+
+type GetEventsOut struct {
+	Events []*Event
+	Next   uint64 // Next is an offset of the event following the last one
+}
+
+// ActivityKind is a kind of team's activity shown on timeline
+type ActivityKind uint
+
+const (
+	ActivityTravel   ActivityKind = iota + 1 // ActivityTravel is team's way to request
+	ActivityCleaning                         // ActivityCleaning is a cleaning of request or its pipeline stage
+	ActivityReturn                           // ActivityReturn is team's way back to base after request
+	ActivityRepair                           // ActivityRepair is a repair of broken team, it has no request
+)
+
+// Interval is a period of team's activity
+type Interval struct {
+	TeamId    uint64
+	RequestId uint64 // RequestId is zero if activity is not related to request
+	Kind      ActivityKind
+	Start     time.Time
+	End       time.Time
+	Ongoing   bool // Ongoing interval has not ended yet, its End is the current time
+}
+
+type GetTimelineIn struct {
+	TeamId *uint64   // TeamId is nil to get every team's activity
+	From   time.Time // From is zero to get activity since the earliest retained interval
+	To     time.Time // To is zero to get activity up to now
+}
+
+type GetTimelineOut struct {
+	Intervals []*Interval // Intervals are in order of their start
+}
@@ -0,0 +1,33 @@
+package logic
+
+import "errors"
+
+var (
+	ErrTeamNotFound    = errors.New("cleaning team is not found")
+	ErrTeamBusy        = errors.New("cleaning team is busy")
+	ErrTeamUnavailable = errors.New("cleaning team is broken down")
+	ErrTeamOffShift    = errors.New("cleaning team is off shift")
+	ErrTeamIneligible  = errors.New("cleaning team is not skilled at cleaning type")
+	ErrNilRequest      = errors.New("nil req")
+	ErrNoArrivals      = errors.New("arrival rate is neither given nor configured")
+	ErrQueueFull       = errors.New("no room for request")
+	ErrBalked          = errors.New("request balked at long queue")
+	ErrInvalidLocation = errors.New("request's location is unknown to travel model")
+	ErrInvalidWindow   = errors.New("invalid time window")
+	ErrNoFreeSlot      = errors.New("no free slot in time window")
+	ErrRateLimited     = errors.New("client's request rate is exceeded")
+	ErrQuotaExceeded   = errors.New("client's quota of waiting requests is exceeded")
+
+	ErrRequestNotFound = errors.New("request not found")
+	ErrRequestExists   = errors.New("request already exists")
+
+	ErrEventsExpired = errors.New("events are no longer retained")
+
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+	ErrSubscriptionExists   = errors.New("subscription already exists")
+	ErrSubscriptionCanceled = errors.New("subscription is canceled")
+	ErrInvalidRule          = errors.New("invalid subscription's recurrence")
+	ErrInvalidSubscription  = errors.New("subscription's id must be in [1, 2^32)")
+	ErrOccurrenceNotFound   = errors.New("subscription has no such occurrence")
+	ErrOccurrenceClosed     = errors.New("occurrence has already started or been skipped")
+)
@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// subscriberBuffer is a size of each subscriber's channel. Slow subscribers lose events instead of blocking service
+const subscriberBuffer = 64
+
+// eventBus is a simple fan-out of service events to subscribers
+type eventBus struct {
+	mu     sync.RWMutex
+	nextId uint64
+	subs   map[uint64]chan *dto.Event
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{
+		subs: make(map[uint64]chan *dto.Event),
+	}
+}
+
+// subscribe registers new subscriber, which is removed and closed when ctx is done
+func (b *eventBus) subscribe(ctx context.Context) <-chan *dto.Event {
+	ch := make(chan *dto.Event, subscriberBuffer)
+
+	b.mu.Lock()
+	id := b.nextId
+	b.nextId++
+	b.subs[id] = ch
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		delete(b.subs, id)
+		close(ch)
+		b.mu.Unlock()
+	}()
+
+	return ch
+}
+
+// publish sends event to every subscriber without blocking.
+// Returns amount of subscribers which missed the event
+func (b *eventBus) publish(e *dto.Event) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	missed := 0
+	for _, ch := range b.subs {
+		select {
+		case ch <- e:
+		default:
+			missed++
+		}
+	}
+
+	return missed
+}
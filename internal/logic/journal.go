@@ -0,0 +1,155 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/eventlog"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// maxEventsBatch is a max amount of events returned at once
+const maxEventsBatch = 1024
+
+// journal numbers service events, keeps the latest of them for streaming and appends them to event log file
+type journal struct {
+	mu       sync.Mutex
+	events   []*dto.Event // events are retained events, a ring starting at head once retained amount is reached
+	head     int
+	next     uint64 // next is a sequence number of the next event
+	retained uint64
+	appended chan struct{}    // appended is closed when event is appended, nil if nobody waits for it
+	file     *eventlog.Writer // file is nil if events are not written to file
+}
+
+func newJournal(c *configs.EventsConfig) (*journal, error) {
+	j := &journal{
+		next:     1,
+		retained: configs.DefEventsRetained,
+	}
+	if c == nil {
+		return j, nil
+	}
+
+	if c.Retained > 0 {
+		j.retained = c.Retained
+	}
+	if c.File != "" {
+		maxSize := c.MaxSize
+		if maxSize <= 0 {
+			maxSize = configs.DefEventsMaxSize
+		}
+
+		// Restarted service continues numbering of its event log
+		last, err := eventlog.LastSeq(c.File)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read event log: %w", err)
+		}
+		j.next = last + 1
+
+		file, err := eventlog.NewWriter(c.File, maxSize, c.MaxFiles)
+		if err != nil {
+			return nil, err
+		}
+		j.file = file
+	}
+
+	return j, nil
+}
+
+// append numbers event and retains it forgetting the earliest events over limit. Returns error if event is not written to file
+func (j *journal) append(e *dto.Event) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	e.Seq = j.next
+	j.next++
+	if uint64(len(j.events)) < j.retained {
+		j.events = append(j.events, e)
+	} else {
+		j.events[j.head] = e
+		j.head = (j.head + 1) % len(j.events)
+	}
+
+	if j.appended != nil {
+		close(j.appended)
+		j.appended = nil
+	}
+
+	if j.file == nil {
+		return nil
+	}
+
+	return j.file.Write(eventlog.NewRecord(e))
+}
+
+// since returns retained events starting from offset and offset to continue from, the last tail events if offset is zero.
+// Returns channel, which is closed when the next event is appended, if there are no such events yet
+func (j *journal) since(offset, tail uint64) ([]*dto.Event, uint64, <-chan struct{}, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	first := j.next - uint64(len(j.events))
+	if offset == 0 {
+		offset = first
+		if tail > 0 && j.next-first > tail {
+			offset = j.next - tail
+		}
+	}
+	if offset < first {
+		return nil, 0, nil, fmt.Errorf("%w: the earliest retained event is %d, requested %d", ErrEventsExpired, first, offset)
+	}
+	if offset >= j.next {
+		if j.appended == nil {
+			j.appended = make(chan struct{})
+		}
+		return nil, offset, j.appended, nil
+	}
+
+	events := make([]*dto.Event, 0, min(j.next-offset, maxEventsBatch))
+	for i := offset - first; i < uint64(len(j.events)) && len(events) < maxEventsBatch; i++ {
+		events = append(events, j.events[(j.head+int(i))%len(j.events)])
+	}
+
+	return events, offset + uint64(len(events)), nil, nil
+}
+
+func (j *journal) close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.file == nil {
+		return nil
+	}
+
+	file := j.file
+	j.file = nil
+	return file.Close()
+}
+
+// GetEvents gets service events starting from offset, e.g. to resume streaming after the last received event.
+// Returns events along with offset to continue from
+func (s *Service) GetEvents(ctx context.Context, in *dto.GetEventsIn) (*dto.GetEventsOut, error) {
+	for {
+		events, next, appended, err := s.journal.since(in.Offset, in.Tail)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) > 0 || !in.Wait {
+			return &dto.GetEventsOut{Events: events, Next: next}, nil
+		}
+
+		select {
+		case <-appended:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Close closes service's event log
+func (s *Service) Close() error {
+	return s.journal.close()
+}
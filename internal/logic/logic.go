@@ -4,69 +4,518 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"sync"
 	"time"
 
-	"golang.org/x/exp/rand"
-
 	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/calendar"
+	"github.com/Bazhenator/cleaner/internal/clock"
 	"github.com/Bazhenator/cleaner/internal/entities"
 	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	"github.com/Bazhenator/cleaner/internal/travel"
+	"github.com/Bazhenator/cleaner/internal/workload"
+	"github.com/Bazhenator/cleaner/pkg/queueing"
 	"github.com/Bazhenator/tools/src/logger"
 )
 
 type Service struct {
-	c  *configs.Config
-	l  *logger.Logger
-	mu sync.Mutex
+	c     *configs.Config
+	l     *logger.Logger
+	mu    sync.Mutex
+	clock clock.Clock
+	rng   *rand.Rand
+
+	teams    []*entities.CleaningTeam
+	queue    *requestQueue
+	registry *requestRegistry
+	selector TeamSelector
+	stats    *systemStats
+	events   *eventBus
+	journal  *journal
+	statuses []entities.Status // statuses are teams' statuses by team id as of the latest event
+	timeline *timeline
+
+	cleanings map[*dto.Request]*cleaning // cleanings are cleanings in progress by request
+	bookings  map[*dto.Request]*booking  // bookings are scheduled requests waiting for their start
+	failures  workload.Distribution
+	repairs   workload.Distribution
+	schedules []*calendar.Schedule // schedules are teams' working hours by team id, nil if teams work around the clock
+	pipelines map[uint][]*stage    // pipelines are stages of cleaning by cleaning type
+	travel    travel.Model         // travel is nil if teams reach clients instantly
+
+	subscriptions map[uint64]*subscription
+	horizon       time.Duration // horizon is how far ahead subscriptions' occurrences are booked
 
-	teams []*entities.CleaningTeam
+	limiters map[uint64]*limiter // limiters are clients' rate limiters by client id
 }
 
-func NewService(c *configs.Config, l *logger.Logger) *Service {
-	// Cleaning teams' initializing
-	teams := initTeams(c.TeamsAmount)
+func NewService(c *configs.Config, l *logger.Logger, opts ...Option) (*Service, error) {
+	selector, err := NewTeamSelector(c.AssignPolicy)
+	if err != nil {
+		return nil, err
+	}
 
-	return &Service{
-		c: c,
-		l: l,
+	s := &Service{
+		c:     c,
+		l:     l,
+		clock: clock.NewReal(),
+		rng:   rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
 
-		teams: teams,
+		queue:    newRequestQueue(),
+		registry: newRequestRegistry(),
+		selector: selector,
+		events:   newEventBus(),
+		timeline: newTimeline(timelineCapacity),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	// Cleaning teams' initializing
+	s.teams = initTeams(c, s.rng, s.clock.Now())
+	s.cleanings = make(map[*dto.Request]*cleaning)
+	s.bookings = make(map[*dto.Request]*booking)
+	s.subscriptions = make(map[uint64]*subscription)
+	s.limiters = make(map[uint64]*limiter)
+	if c.Clients != nil && c.Clients.FairQueueing {
+		s.queue.serveFairly(c.Clients.Weights)
+	}
+	s.horizon = configs.DefSubscriptionsHorizon * time.Second
+	s.stats = newSystemStats(s.clock.Now())
+	if s.journal, err = newJournal(c.Events); err != nil {
+		return nil, err
+	}
+	for _, team := range s.teams {
+		s.statuses = append(s.statuses, team.Status)
 	}
+	if s.pipelines, err = newPipelines(c.Pipelines, c.TeamsAmount); err != nil {
+		return nil, err
+	}
+	if c.Travel != nil {
+		if err := s.initTravel(c.Travel); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Breakdowns != nil {
+		if err := s.initBreakdowns(c.Breakdowns); err != nil {
+			return nil, err
+		}
+	}
+	if c.Calendar != nil {
+		if err := s.initShifts(c.Calendar); err != nil {
+			return nil, err
+		}
+	}
+	if c.Subscriptions != nil {
+		if err := s.initSubscriptions(c.Subscriptions); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
 }
 
 // ProceedCleaningRequest proceeds request from user, assigns it to cleaning team and processes it.
 // Returns cleaning duration
 func (s *Service) ProceedCleaningRequest(ctx context.Context, in *dto.ProceedCleaningRequestIn) (*dto.ProceedCleaningRequestOut, error) {
+	if in.Request == nil {
+		s.l.ErrorCtx(ctx, "Request came nil", logger.NewErrorField(ErrNilRequest))
+		return nil, ErrNilRequest
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Retried submission gets the result of the first one instead of being cleaned twice
+	existing, err := s.registry.repeated(in.Request)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &dto.ProceedCleaningRequestOut{Req: snapshot(existing)}, nil
+	}
+
+	if in.TeamId >= uint64(len(s.teams)) {
+		return nil, fmt.Errorf("%w: team %d", ErrTeamNotFound, in.TeamId)
+	}
+	if err := s.checkLocation(in.Request); err != nil {
+		return nil, err
+	}
+	s.enterPipeline(in.Request)
+	if !s.canServe(s.teams[in.TeamId], in.Request) || !s.servable(in.Request) {
+		return nil, fmt.Errorf("%w: team %d, cleaning type %d", ErrTeamIneligible, in.TeamId, in.Request.CleaningType)
+	}
+
+	// Request refused by busy team is lost, as in loss system
+	s.stats.arrive(in.Request)
+	in.Request.SubmittedAt = s.clock.Now()
+	in.Request.EnqueuedAt = in.Request.SubmittedAt
+	s.registry.add(in.Request)
+	s.publish(dto.EventRequestSubmitted, nil, in.Request)
+	if err := s.throttle(in.Request); err != nil {
+		return nil, err
+	}
+
+	// Team's slots booked for scheduled requests are not given away
 	team := s.teams[in.TeamId]
-	duration := team.GetCleaningTime(s.c.BaseSpeed)
-	team.AssignRequest(in.Request)
+	if team.Status != entities.Available || !s.fits(team, in.Request, s.clock.Now()) {
+		s.reject(in.Request)
+		switch team.Status {
+		case entities.Unavailable:
+			return nil, fmt.Errorf("%w: team %d", ErrTeamUnavailable, in.TeamId)
+		case entities.OffShift:
+			return nil, fmt.Errorf("%w: team %d", ErrTeamOffShift, in.TeamId)
+		}
+		return nil, fmt.Errorf("%w: team %d", ErrTeamBusy, in.TeamId)
+	}
+
+	s.startCleaning(team, in.Request)
+
+	return &dto.ProceedCleaningRequestOut{Req: snapshot(in.Request)}, nil
+}
+
+// SubmitCleaningRequest puts request to the service's queue.
+// Request is assigned automatically to a team chosen by assignment policy as soon as one becomes available.
+// Returns request's state, retried submission gets the state of the request submitted first
+func (s *Service) SubmitCleaningRequest(ctx context.Context, req *dto.Request) (*dto.Request, error) {
+	if req == nil {
+		s.l.ErrorCtx(ctx, "Request came nil", logger.NewErrorField(ErrNilRequest))
+		return nil, ErrNilRequest
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Retried submission is already waiting or has been served
+	existing, err := s.registry.repeated(req)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return snapshot(existing), nil
+	}
+
+	if err := s.checkLocation(req); err != nil {
+		return nil, err
+	}
+	s.enterPipeline(req)
+	if !s.servable(req) {
+		return nil, fmt.Errorf("%w: no team cleans type %d", ErrTeamIneligible, req.CleaningType)
+	}
 
-	go func(team *entities.CleaningTeam, duration time.Duration) {
-		time.Sleep(duration)
+	now := s.clock.Now()
+	req.SubmittedAt = now
+	req.EnqueuedAt = now
+	req.Status = dto.RequestQueued
+	s.stats.arrive(req)
+	s.registry.add(req)
+	s.publish(dto.EventRequestSubmitted, nil, req)
+
+	if err := s.throttle(req); err != nil {
+		return nil, err
+	}
+	if err := s.admit(req); err != nil {
+		return nil, err
+	}
+	s.enqueue(req)
+
+	return snapshot(req), nil
+}
+
+// enqueue puts admitted request to the queue and dispatches it. Must be called under s.mu
+func (s *Service) enqueue(req *dto.Request) {
+	now := s.clock.Now()
+	s.stats.trackQueue(now, s.queue.Len())
+	item := s.queue.push(req)
+	s.stats.maxQueue = max(s.stats.maxQueue, uint64(s.queue.Len()))
+
+	s.dispatch()
+	s.armRenege(item, now)
+}
+
+// armRenege makes request, which is still waiting after dispatch, renege when its patience runs out.
+// Must be called under s.mu
+func (s *Service) armRenege(item *queuedRequest, now time.Time) {
+	if item.index < 0 {
+		return
+	}
+
+	if patience, ok := s.patience(item.req, now); ok {
+		item.renege = s.clock.AfterFunc(patience, func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			s.renege(item)
+		})
+	}
+}
+
+// admit checks whether request joins the queue, when it has to wait for a team. Must be called under s.mu
+func (s *Service) admit(req *dto.Request) error {
+	if len(s.eligibleTeams(req)) > 0 {
+		return nil
+	}
+
+	if threshold := s.c.BalkThreshold; threshold != nil && uint64(s.queue.Len()) >= *threshold {
+		s.stats.balked++
+		s.finish(req, dto.RequestBalked)
+		s.publish(dto.EventRequestBalked, nil, req)
+		return fmt.Errorf("%w: %d requests are waiting", ErrBalked, s.queue.Len())
+	}
+
+	if capacity := s.c.QueueCapacity; capacity != nil && uint64(s.queue.Len()) >= *capacity {
+		s.reject(req)
+		return fmt.Errorf("%w: queue is full, %d requests are waiting", ErrQueueFull, s.queue.Len())
+	}
+	if limit, ok := s.c.PriorityLimits[req.Priority]; ok && s.queue.count(req.Priority) >= limit {
+		s.reject(req)
+		return fmt.Errorf("%w: %d requests of priority %d are waiting", ErrQueueFull, s.queue.count(req.Priority), req.Priority)
+	}
+	if c := s.c.Clients; c != nil && c.MaxWaiting > 0 && s.queue.countOf(req.ClientId) >= c.MaxWaiting {
+		s.reject(req)
+		return fmt.Errorf("%w: client %d has %d requests waiting", ErrQuotaExceeded, req.ClientId, s.queue.countOf(req.ClientId))
+	}
+
+	return nil
+}
+
+// reject refuses request, which does not fit the queue. Must be called under s.mu
+func (s *Service) reject(req *dto.Request) {
+	s.stats.rejected++
+	s.finish(req, dto.RequestRejected)
+	s.publish(dto.EventRequestRejected, nil, req)
+}
+
+// patience returns how long request may wait in queue since now: patience left after time already spent in queue
+// or time until deadline, whichever is less. Returns false if request waits as long as needed
+func (s *Service) patience(req *dto.Request, now time.Time) (time.Duration, bool) {
+	patience, ok := max(req.Patience-req.WaitTime, 0), req.Patience > 0
+	if !req.Deadline.IsZero() {
+		untilDeadline := max(req.Deadline.Sub(now), 0)
+		if !ok || untilDeadline < patience {
+			patience, ok = untilDeadline, true
+		}
+	}
+
+	return patience, ok
+}
+
+// renege drops request, which has run out of patience, from the queue. Must be called under s.mu
+func (s *Service) renege(item *queuedRequest) {
+	s.stats.trackQueue(s.clock.Now(), s.queue.Len())
+	if !s.queue.remove(item) {
+		return
+	}
+
+	s.stats.reneged++
+	s.finish(item.req, dto.RequestReneged)
+	s.publish(dto.EventRequestReneged, nil, item.req)
+}
+
+// finish marks request as finished with given status. Must be called under s.mu
+func (s *Service) finish(req *dto.Request, status dto.RequestStatus) {
+	req.Status = status
+	req.FinishedAt = s.clock.Now()
+	s.registry.finish(req)
+	s.stats.leave(req)
+}
+
+// startCleaning assigns request to team and schedules cleaning's completion. Must be called under s.mu
+func (s *Service) startCleaning(team *entities.CleaningTeam, req *dto.Request) {
+	now := s.clock.Now()
+	duration := team.GetCleaningTime(s.rng, s.c.BaseSpeed, req.CleaningType)
+	travelTime := s.goTo(team, req)
+	team.AssignRequest(req, now)
+	wait := now.Sub(req.EnqueuedAt)
+	req.Status = dto.RequestInProgress
+	req.WaitTime += wait
+	if st := s.stageOf(req); st != nil {
+		duration = time.Duration(float64(duration) * st.weight)
+		stageTime := &req.Stages[req.Stage]
+		stageTime.TeamId = team.Id
+		stageTime.WaitTime += wait
+		stageTime.TimeInCleaner += duration
+	}
+	if st := s.trackStage(req); st != nil {
+		st.started++
+		st.totalWait += wait
+	}
+	req.TimeInCleaner += duration
+	req.TravelTime += travelTime
+	req.Pinned = false
+
+	s.stats.started++
+	s.stats.totalWait += wait
+	s.stats.totalTravel += travelTime
+	s.publish(dto.EventRequestAssigned, team, req)
+
+	c := &cleaning{duration: duration, travel: travelTime}
+	arrival := now.Add(travelTime)
+	if travelTime > 0 {
+		c.intervals = append(c.intervals, s.timeline.add(team.Id, req.Id, dto.ActivityTravel, now, arrival))
+	}
+	c.intervals = append(c.intervals, s.timeline.add(team.Id, req.Id, dto.ActivityCleaning, arrival, arrival.Add(duration)))
+	if travelTime > 0 {
+		c.arrival = s.clock.AfterFunc(travelTime, func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			// Team, which has broken down on the way, never arrives
+			if s.cleanings[req] != c {
+				return
+			}
+			s.publish(dto.EventRequestStarted, team, req)
+		})
+	} else {
+		s.publish(dto.EventRequestStarted, team, req)
+	}
+	c.timer = s.clock.AfterFunc(travelTime+duration, func() {
 		s.mu.Lock()
 		defer s.mu.Unlock()
 
-		team.CompleteCleaning(team.StartedAt)
+		// Timer may fire while cleaning is being interrupted, then it is not the request's cleaning anymore
+		if s.cleanings[req] != c {
+			return
+		}
+		s.completeCleaning(team, req)
+	})
+	s.cleanings[req] = c
+}
 
-		s.l.Info(fmt.Sprintf("Team %d completed cleaning.", team.Id))
-	}(team, duration)
+// cancelCleanings stops scheduled completions of team's cleanings, requests are charged only for cleaning time spent.
+// Must be called under s.mu
+func (s *Service) cancelCleanings(team *entities.CleaningTeam) {
+	now := s.clock.Now()
+	for _, req := range team.Requests {
+		s.charge(team, req, now)
+		c := s.cleanings[req]
+		c.timer.Stop()
+		if c.arrival != nil {
+			c.arrival.Stop()
+		}
+		delete(s.cleanings, req)
+		for _, iv := range c.intervals {
+			cut(iv, now)
+		}
+		travelled := min(now.Sub(req.StartedAt), c.travel)
+		spent := now.Sub(req.StartedAt) - travelled
+		req.TimeInCleaner -= c.duration - spent
+		req.TravelTime -= c.travel - travelled
+		team.TotalTravelTime += travelled
+		s.stats.totalTravel -= c.travel - travelled
+		if req.Stages != nil {
+			req.Stages[req.Stage].TimeInCleaner -= c.duration - spent
+			s.trackStage(req).totalInCleaner += spent
+		}
+	}
+}
+
+// requeue puts request, which cleaning has not been finished, back to the queue. Request reneges
+// if it waits longer than its remaining patience or past its deadline. Must be called under s.mu
+func (s *Service) requeue(req *dto.Request) {
+	now := s.clock.Now()
+	req.Status = dto.RequestQueued
+	req.EnqueuedAt = now
+	s.stats.trackQueue(now, s.queue.Len())
+	item := s.queue.push(req)
+	s.stats.maxQueue = max(s.stats.maxQueue, uint64(s.queue.Len()))
+
+	s.dispatch()
+	s.armRenege(item, now)
+}
+
+// completeCleaning frees team's slot after cleaning and gives it next queued request.
+// Request, which has stages left or has failed inspection, is queued again. Must be called under s.mu
+func (s *Service) completeCleaning(team *entities.CleaningTeam, req *dto.Request) {
+	now := s.clock.Now()
+	c := s.cleanings[req]
+	s.charge(team, req, now)
+	team.CompleteCleaning(req, now)
+	team.TotalTravelTime += c.travel
+	s.returnToBase(team, req)
+	if st := s.trackStage(req); st != nil {
+		st.completed++
+		st.totalInCleaner += c.duration
+	}
+	delete(s.cleanings, req)
+
+	if s.advance(req) {
+		s.publish(dto.EventStageCompleted, team, req)
+		s.requeue(req)
+		return
+	}
+	if !s.inspect(req) {
+		return
+	}
+
+	s.stats.completed++
+	s.stats.totalResponse += now.Sub(req.SubmittedAt)
+	s.bill(team, req)
+	s.finish(req, dto.RequestCompleted)
+	s.publish(dto.EventRequestCompleted, team, req)
 
-	team.Request.TimeInCleaner += duration
+	s.l.Debug(fmt.Sprintf("Team %d completed cleaning.", team.Id))
 
-	processedReq := team.Request
-	if processedReq == nil {
-		s.l.ErrorCtx(ctx, "Request came nil after cleaning", logger.NewErrorField(errors.New("nil req")))
-		return nil, errors.New("nil req")
+	s.dispatch()
+}
+
+// dispatch assigns queued requests to available eligible teams while there are both. Must be called under s.mu
+func (s *Service) dispatch() {
+	for s.queue.Len() > 0 {
+		if s.availableTeams() == 0 {
+			return
+		}
+
+		// The most urgent request, which has an eligible team available, is served first
+		s.stats.trackQueue(s.clock.Now(), s.queue.Len())
+		req := s.queue.popFirst(func(req *dto.Request) bool {
+			return len(s.eligibleTeams(req)) > 0
+		})
+		if req == nil {
+			return
+		}
+
+		s.startCleaning(s.selector(s.rng, s.eligibleTeams(req)), req)
+	}
+}
+
+// eligibleTeams returns available teams, which are able to clean request's current stage without breaking their reservations.
+// Must be called under s.mu
+func (s *Service) eligibleTeams(req *dto.Request) []*entities.CleaningTeam {
+	now := s.clock.Now()
+	eligible := make([]*entities.CleaningTeam, 0, len(s.teams))
+	for _, team := range s.teams {
+		if team.Status == entities.Available && s.canServe(team, req) && s.fits(team, req, now) {
+			eligible = append(eligible, team)
+		}
 	}
 
-	return &dto.ProceedCleaningRequestOut{Req: processedReq}, nil
+	return eligible
 }
 
-// GetAvailableTeams checks available teams in cleaning service.
-// Returns available cleaning teams' IDs
-func (s *Service) GetAvailableTeams(ctx context.Context) (*dto.GetAvailableTeamsOut, error) {
+// GetRequest finds request in service's registry.
+// Returns request's current state
+func (s *Service) GetRequest(ctx context.Context, id uint64) (*dto.GetRequestOut, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req, ok := s.registry.get(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: request %d", ErrRequestNotFound, id)
+	}
+	return &dto.GetRequestOut{Req: snapshot(req)}, nil
+}
+
+// GetAvailableTeams checks available teams in cleaning service, which are able to clean requested type if any.
+// Returns available cleaning teams' IDs along with their free slots
+func (s *Service) GetAvailableTeams(ctx context.Context, in *dto.GetAvailableTeamsIn) (*dto.GetAvailableTeamsOut, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	availables := make([]uint64, 0, s.c.TeamsAmount)
+	freeSlots := make([]uint64, 0, s.c.TeamsAmount)
 
 	if len(s.teams) == 0 {
 		s.l.Error("teams are not initialized")
@@ -74,47 +523,277 @@ func (s *Service) GetAvailableTeams(ctx context.Context) (*dto.GetAvailableTeams
 	}
 
 	for _, team := range s.teams {
-		if team.Status == entities.Available {
-			availables = append(availables, team.Id)
+		if team.Status != entities.Available {
+			continue
+		}
+		if in.CleaningType != nil && !team.CanClean(*in.CleaningType) {
+			continue
 		}
+		availables = append(availables, team.Id)
+		freeSlots = append(freeSlots, team.FreeSlots())
 	}
 
-	return &dto.GetAvailableTeamsOut{Teams: availables}, nil
+	return &dto.GetAvailableTeamsOut{Teams: availables, FreeSlots: freeSlots}, nil
 }
 
 // GetTeamsStats gets statistics of each team in cleaning service, while working to build statistic table for dispatcher.
 // Returns all cleaning teams' statistics.
 func (s *Service) GetTeamsStats(ctx context.Context) (*dto.GetTeamsStatsOut, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	stats := s.teams
 	if stats == nil {
 		s.l.Error("teams array is nil")
 		return nil, errors.New("no cleanning teams in service")
 	}
 
+	now := s.clock.Now()
 	answer := make([]*dto.TeamStats, 0, len(stats))
 	for _, stat := range stats {
+		active := make([]uint64, 0, len(stat.Requests))
+		for _, req := range stat.Requests {
+			active = append(active, req.Id)
+		}
+
 		answer = append(answer, &dto.TeamStats{
-			Id: stat.Id,
-			Speed: uint32(stat.Speed),
-			ProcessedRequests: stat.ProcessedRequests,
-			TotalBusyTime: stat.TotalBusyTime,
+			Id:                 stat.Id,
+			Speed:              uint32(stat.Speed),
+			Skills:             stat.Skills,
+			Status:             uint32(stat.Status),
+			Capacity:           stat.Capacity,
+			FreeSlots:          stat.FreeSlots(),
+			ActiveRequests:     active,
+			ProcessedRequests:  stat.ProcessedRequests,
+			TotalBusyTime:      stat.TotalBusyTime,
+			Utilization:        utilization(stat, now),
+			Failures:           stat.Failures,
+			TotalDownTime:      stat.TotalDownTime + stat.DownTimeSince(now),
+			Availability:       availability(stat, now),
+			OnShift:            stat.OnShift,
+			ShiftTime:          stat.TotalShiftTime + stat.ShiftTimeSince(now),
+			OnShiftUtilization: onShiftUtilization(stat, now),
+			Overtime:           stat.TotalOvertime + stat.OvertimeSince(now),
+			Handoffs:           stat.Handoffs,
+			Reworks:            stat.Reworks,
+			ReworkRate:         reworkRate(stat.Reworks, stat.ProcessedRequests),
+			TravelTime:         stat.TotalTravelTime,
+			Position:           stat.Position,
+			Cost:               s.teamCost(stat, now),
+			Revenue:            stat.Revenue,
 		})
 	}
 
 	return &dto.GetTeamsStatsOut{Stats: answer}, nil
 }
 
-// initTeams - private func for initializing cleaner service's teams during the first connection to service
-func initTeams(size uint64) []*entities.CleaningTeam {
-	teams := make([]*entities.CleaningTeam, 0, size)
+// GetSystemStats gets service-wide statistics: arrivals, waiting and response times, queue length and utilization.
+// Returns statistics collected since service's start or last statistics reset
+func (s *Service) GetSystemStats(ctx context.Context) (*dto.GetSystemStatsOut, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	st := s.stats
+	elapsed := now.Sub(st.since)
+
+	out := &dto.GetSystemStatsOut{
+		Since:          st.since,
+		Elapsed:        elapsed,
+		Arrivals:       st.arrivals,
+		Started:        st.started,
+		Completed:      st.completed,
+		Rejected:       st.rejected,
+		Reneged:        st.reneged,
+		Balked:         st.balked,
+		Interrupted:    st.interrupted,
+		Failed:         st.failed,
+		Handoffs:       st.handoffs,
+		Reworked:       st.reworked,
+		Scheduled:      st.scheduled,
+		Canceled:       st.canceled,
+		ReworkRate:     reworkRate(st.reworked, st.completed+st.reworked),
+		QueueLength:    uint64(s.queue.Len()),
+		MaxQueueLength: max(st.maxQueue, uint64(s.queue.Len())),
+	}
+
+	if st.started > 0 {
+		out.MeanWaitTime = st.totalWait / time.Duration(st.started)
+		out.MeanTravelTime = st.totalTravel / time.Duration(st.started)
+	}
+	if st.completed > 0 {
+		out.MeanResponseTime = st.totalResponse / time.Duration(st.completed)
+	}
+	if elapsed > 0 {
+		out.MeanQueueLength = st.queueAreaAt(now, s.queue.Len()) / elapsed.Seconds()
+		out.Throughput = float64(st.completed) / elapsed.Seconds()
+	}
+	if len(s.teams) > 0 {
+		for _, team := range s.teams {
+			out.Utilization += utilization(team, now)
+			out.Availability += availability(team, now)
+			out.OnShiftUtilization += onShiftUtilization(team, now)
+			out.Overtime += team.TotalOvertime + team.OvertimeSince(now)
+			out.TravelTime += team.TotalTravelTime
+			out.Cost += s.teamCost(team, now)
+			out.Revenue += team.Revenue
+		}
+		out.Utilization /= float64(len(s.teams))
+		out.Availability /= float64(len(s.teams))
+		out.OnShiftUtilization /= float64(len(s.teams))
+		out.Profit = out.Revenue - out.Cost
+	}
+	out.Stages = s.stagesStats()
+
+	return out, nil
+}
+
+// GetTheoreticalMetrics evaluates analytic queueing models of the service built from teams' speeds, base speed and arrival rate.
+// Returns models' metrics to be compared with simulated ones
+func (s *Service) GetTheoreticalMetrics(ctx context.Context, in *dto.GetTheoreticalMetricsIn) (*dto.GetTheoreticalMetricsOut, error) {
+	params := &queueing.Params{ArrivalRate: in.ArrivalRate}
+	if params.ArrivalRate <= 0 && s.c.Generator != nil {
+		params.ArrivalRate = s.c.Generator.Rate
+	}
+	if params.ArrivalRate <= 0 {
+		return nil, ErrNoArrivals
+	}
+
+	s.mu.Lock()
+	for _, team := range s.teams {
+		for range team.Capacity {
+			params.ServiceRates = append(params.ServiceRates, 1/team.MeanCleaningTime(s.c.BaseSpeed).Seconds())
+		}
+	}
+	s.mu.Unlock()
+
+	queueCapacity := in.QueueCapacity
+	if queueCapacity < 0 && s.c.QueueCapacity != nil {
+		queueCapacity = int(*s.c.QueueCapacity)
+	}
+
+	models, err := params.Evaluate(queueCapacity, in.ServiceScv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GetTheoreticalMetricsOut{
+		ArrivalRate:     params.ArrivalRate,
+		MeanServiceRate: params.MeanServiceRate(),
+		Teams:           uint64(params.Servers()),
+		Models:          models,
+	}, nil
+}
+
+// ResetStats starts collecting statistics anew, e.g. after simulation's warm-up period
+func (s *Service) ResetStats(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	for _, team := range s.teams {
+		team.ResetStats(now)
+	}
+	s.stats = newSystemStats(now)
+}
+
+// Subscribe subscribes caller to service's events until ctx is done.
+// Returns channel, which is closed after unsubscribing
+func (s *Service) Subscribe(ctx context.Context) <-chan *dto.Event {
+	return s.events.subscribe(ctx)
+}
+
+// publish notifies subscribers about event happened with request and team, either of them may be nil.
+// Changes of teams' statuses caused by event follow it. Must be called under s.mu
+func (s *Service) publish(t dto.EventType, team *entities.CleaningTeam, req *dto.Request) {
+	s.emit(t, team, req)
+
+	for _, team := range s.teams {
+		if team.Status != s.statuses[team.Id] {
+			s.statuses[team.Id] = team.Status
+			s.emit(dto.EventTeamStatusChanged, team, nil)
+		}
+	}
+}
+
+// emit appends event to journal and sends it to subscribers. Must be called under s.mu
+func (s *Service) emit(t dto.EventType, team *entities.CleaningTeam, req *dto.Request) {
+	e := &dto.Event{
+		Type:           t,
+		AvailableTeams: s.availableTeams(),
+		Time:           s.clock.Now(),
+	}
+	if req != nil {
+		e.RequestId = req.Id
+		e.Request = snapshot(req)
+	}
+	if team != nil {
+		teamId := team.Id
+		e.TeamId = &teamId
+		e.TeamStatus = uint(team.Status)
+	}
+
+	if err := s.journal.append(e); err != nil {
+		s.l.Warn("failed to write event to event log", logger.NewErrorField(err))
+	}
+	if missed := s.events.publish(e); missed > 0 {
+		s.l.Warn("some subscribers missed service event", logger.NewField("missed", missed))
+	}
+}
+
+// availableTeams counts teams, which have free slots to take request. Must be called under s.mu
+func (s *Service) availableTeams() uint64 {
+	var amount uint64
+	for _, team := range s.teams {
+		if team.Status == entities.Available {
+			amount++
+		}
+	}
+
+	return amount
+}
+
+// utilization returns share of slots' time they have been busy since statistics are collected
+func utilization(team *entities.CleaningTeam, now time.Time) float64 {
+	elapsed := now.Sub(team.StatsSince)
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return (team.TotalBusyTime + team.BusyTimeSince(now)).Seconds() / (elapsed.Seconds() * float64(team.Capacity))
+}
+
+// initTeams - private func for initializing cleaner service's teams during the first connection to service.
+// Teams' speeds are taken from config or chosen randomly, teams clean one request at once unless capacities are configured
+func initTeams(c *configs.Config, rng *rand.Rand, now time.Time) []*entities.CleaningTeam {
+	teams := make([]*entities.CleaningTeam, 0, c.TeamsAmount)
+
+	for i := uint64(0); i < c.TeamsAmount; i++ {
+		speed := entities.Speed(rng.IntN(3) + 1)
+		if i < uint64(len(c.TeamsSpeeds)) {
+			speed = entities.Speed(c.TeamsSpeeds[i])
+		}
+
+		var skills map[uint]float64
+		if i < uint64(len(c.TeamsSkills)) {
+			skills = c.TeamsSkills[i]
+		}
+
+		capacity := uint64(1)
+		if i < uint64(len(c.TeamsCapacities)) {
+			capacity = c.TeamsCapacities[i]
+		}
 
-	for i := uint64(0); i < size; i++ {
 		teams = append(teams, &entities.CleaningTeam{
-			Id:        uint64(i),
-			Request:   nil,
-			Status:    entities.Available,
-			Speed:     entities.Speed(rand.Intn(3) + 1),
-			StartedAt: time.Time{},
+			Id:         uint64(i),
+			Capacity:   capacity,
+			Status:     entities.Available,
+			Speed:      speed,
+			Skills:     skills,
+			StatsSince: now,
+
+			OnShift:        true,
+			ShiftStartedAt: now,
 		})
 	}
 
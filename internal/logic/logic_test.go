@@ -0,0 +1,364 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/clock"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	"github.com/Bazhenator/tools/src/logger"
+)
+
+var testStart = time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+// newTestService returns service on virtual clock, which starts at testStart
+func newTestService(t *testing.T, c *configs.Config) (*Service, *clock.Virtual) {
+	t.Helper()
+
+	if c.AssignPolicy == "" {
+		c.AssignPolicy = configs.DefAssignPolicy
+	}
+	if c.BaseSpeed == 0 {
+		c.BaseSpeed = 60
+	}
+
+	clk := clock.NewVirtual(testStart)
+	s, err := NewService(c, &logger.Logger{Logger: zap.NewNop()}, WithClock(clk), WithRand(rand.New(rand.NewPCG(1, 2))))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s, clk
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func statsOf(t *testing.T, s *Service) *dto.GetSystemStatsOut {
+	t.Helper()
+
+	out, err := s.GetSystemStats(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return out
+}
+
+func TestSubmitAdmission(t *testing.T) {
+	tests := []struct {
+		name       string
+		capacity   *uint64
+		limits     map[uint]uint64
+		priorities []uint // priorities are submitted one by one to a single team
+		rejected   []bool // rejected tell which submissions are refused
+	}{
+		{
+			name:       "unbounded queue",
+			priorities: []uint{1, 1, 1, 1},
+			rejected:   []bool{false, false, false, false},
+		},
+		{
+			name:       "zero capacity",
+			capacity:   ptr[uint64](0),
+			priorities: []uint{1, 1, 1},
+			rejected:   []bool{false, true, true},
+		},
+		{
+			name:       "capacity of two",
+			capacity:   ptr[uint64](2),
+			priorities: []uint{1, 1, 1, 1},
+			rejected:   []bool{false, false, false, true},
+		},
+		{
+			name:       "priority limit",
+			limits:     map[uint]uint64{2: 1},
+			priorities: []uint{1, 2, 2, 1, 2},
+			rejected:   []bool{false, false, true, false, true},
+		},
+		{
+			name:       "priority limit under capacity",
+			capacity:   ptr[uint64](2),
+			limits:     map[uint]uint64{2: 1},
+			priorities: []uint{2, 2, 1, 2, 1},
+			rejected:   []bool{false, false, false, true, true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestService(t, &configs.Config{TeamsAmount: 1, QueueCapacity: tt.capacity, PriorityLimits: tt.limits})
+
+			var rejected uint64
+			for i, priority := range tt.priorities {
+				_, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: uint64(i + 1), Priority: priority})
+				if tt.rejected[i] {
+					rejected++
+					if !errors.Is(err, ErrQueueFull) {
+						t.Fatalf("submission %d: error = %v, want %v", i, err, ErrQueueFull)
+					}
+				} else if err != nil {
+					t.Fatalf("submission %d: %v", i, err)
+				}
+			}
+
+			st := statsOf(t, s)
+			if st.Rejected != rejected {
+				t.Fatalf("rejected = %d, want %d", st.Rejected, rejected)
+			}
+			if st.Arrivals != uint64(len(tt.priorities)) {
+				t.Fatalf("arrivals = %d, want %d", st.Arrivals, len(tt.priorities))
+			}
+			if want := uint64(len(tt.priorities)) - rejected - 1; st.QueueLength != want {
+				t.Fatalf("queue length = %d, want %d", st.QueueLength, want)
+			}
+		})
+	}
+}
+
+func TestQueuedRequestIsAssignedToFreedTeam(t *testing.T) {
+	s, clk := newTestService(t, &configs.Config{TeamsAmount: 1, QueueCapacity: ptr[uint64](1)})
+
+	for id := uint64(1); id <= 2; id++ {
+		if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if st := statsOf(t, s); st.Started != 1 || st.QueueLength != 1 {
+		t.Fatalf("started = %d, queue length = %d, want 1 and 1", st.Started, st.QueueLength)
+	}
+
+	clk.Run()
+
+	st := statsOf(t, s)
+	if st.Completed != 2 || st.QueueLength != 0 {
+		t.Fatalf("completed = %d, queue length = %d, want 2 and 0", st.Completed, st.QueueLength)
+	}
+	if st.MeanWaitTime <= 0 {
+		t.Fatalf("mean wait time = %s, want waiting of queued request", st.MeanWaitTime)
+	}
+}
+
+func requestOf(t *testing.T, s *Service, id uint64) *dto.Request {
+	t.Helper()
+
+	out, err := s.GetRequest(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return out.Req
+}
+
+func TestSubmitBalks(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold uint64
+		capacity  *uint64
+		submitted int   // submitted is amount of requests submitted to a single team
+		balked    []int // balked are indexes of balking requests
+	}{
+		{name: "empty queue", threshold: 0, submitted: 3, balked: []int{1, 2}},
+		{name: "threshold of two", threshold: 2, submitted: 5, balked: []int{3, 4}},
+		{name: "balking precedes rejection", threshold: 1, capacity: ptr[uint64](1), submitted: 3, balked: []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestService(t, &configs.Config{TeamsAmount: 1, BalkThreshold: &tt.threshold, QueueCapacity: tt.capacity})
+
+			for i := range tt.submitted {
+				_, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: uint64(i + 1)})
+				balks := slices.Contains(tt.balked, i)
+				if balks != errors.Is(err, ErrBalked) || !balks && err != nil {
+					t.Fatalf("submission %d: error = %v, want balking = %t", i, err, balks)
+				}
+				if balks {
+					if req := requestOf(t, s, uint64(i+1)); req.Status != dto.RequestBalked || !req.FinishedAt.Equal(testStart) {
+						t.Fatalf("balked request's status = %d, finished at %s", req.Status, req.FinishedAt)
+					}
+				}
+			}
+
+			st := statsOf(t, s)
+			if st.Balked != uint64(len(tt.balked)) || st.Rejected != 0 {
+				t.Fatalf("balked = %d, rejected = %d, want %d and 0", st.Balked, st.Rejected, len(tt.balked))
+			}
+		})
+	}
+}
+
+func TestQueuedRequestsRenege(t *testing.T) {
+	s, clk := newTestService(t, &configs.Config{TeamsAmount: 1})
+
+	ctx := context.Background()
+	if _, err := s.SubmitCleaningRequest(ctx, &dto.Request{Id: 1}); err != nil {
+		t.Fatal(err)
+	}
+	d := requestOf(t, s, 1).TimeInCleaner
+
+	// Team is busy for d, so only the patient request waits for it
+	waiting := []*dto.Request{
+		{Id: 2, Patience: d / 2},
+		{Id: 3, Deadline: testStart.Add(d / 4)},
+		{Id: 4, Patience: 2 * d, Deadline: testStart.Add(3 * d)},
+	}
+	for _, req := range waiting {
+		if _, err := s.SubmitCleaningRequest(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clk.Run()
+
+	tests := []struct {
+		id       uint64
+		status   dto.RequestStatus
+		finished time.Duration
+	}{
+		{2, dto.RequestReneged, d / 2},
+		{3, dto.RequestReneged, d / 4},
+		{4, dto.RequestCompleted, d + requestOf(t, s, 4).TimeInCleaner},
+	}
+	for _, tt := range tests {
+		req := requestOf(t, s, tt.id)
+		if req.Status != tt.status || !req.FinishedAt.Equal(testStart.Add(tt.finished)) {
+			t.Fatalf("request %d: status = %d, finished at %s, want %d at %s",
+				tt.id, req.Status, req.FinishedAt, tt.status, testStart.Add(tt.finished))
+		}
+	}
+
+	if wait := requestOf(t, s, 4).WaitTime; wait != d {
+		t.Fatalf("wait time = %s, want %s", wait, d)
+	}
+	if st := statsOf(t, s); st.Reneged != 2 || st.Completed != 2 {
+		t.Fatalf("reneged = %d, completed = %d, want 2 and 2", st.Reneged, st.Completed)
+	}
+}
+
+func TestTeamCapacity(t *testing.T) {
+	s, clk := newTestService(t, &configs.Config{TeamsAmount: 1, TeamsCapacities: []uint64{2}})
+	ctx := context.Background()
+
+	freeSlots := func() []uint64 {
+		out, err := s.GetAvailableTeams(ctx, &dto.GetAvailableTeamsIn{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out.FreeSlots
+	}
+
+	if _, err := s.SubmitCleaningRequest(ctx, &dto.Request{Id: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if slots := freeSlots(); !slices.Equal(slots, []uint64{1}) {
+		t.Fatalf("free slots = %v, want [1]", slots)
+	}
+
+	// Team, which has a single request, is half utilized
+	d := requestOf(t, s, 1).TimeInCleaner
+	clk.RunUntil(testStart.Add(d))
+	if u := teamStatsOf(t, s, 0).Utilization; u != 0.5 {
+		t.Fatalf("utilization = %f, want 0.5", u)
+	}
+
+	for id := uint64(2); id <= 4; id++ {
+		if _, err := s.SubmitCleaningRequest(ctx, &dto.Request{Id: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if slots := freeSlots(); len(slots) != 0 {
+		t.Fatalf("free slots = %v, want full team not to be available", slots)
+	}
+	if st := statsOf(t, s); st.Started != 3 || st.QueueLength != 1 {
+		t.Fatalf("started = %d, queue length = %d, want 3 and 1", st.Started, st.QueueLength)
+	}
+
+	// The queued request takes the slot freed first
+	first := testStart.Add(d + min(requestOf(t, s, 2).TimeInCleaner, requestOf(t, s, 3).TimeInCleaner))
+	clk.RunUntil(first)
+	if req := requestOf(t, s, 4); req.Status != dto.RequestInProgress || req.WaitTime != first.Sub(testStart.Add(d)) {
+		t.Fatalf("status = %d, wait time = %s, want %d after %s", req.Status, req.WaitTime, dto.RequestInProgress, first.Sub(testStart.Add(d)))
+	}
+}
+
+func TestSubmitIsIdempotent(t *testing.T) {
+	s, clk := newTestService(t, &configs.Config{TeamsAmount: 1, QueueCapacity: ptr[uint64](1)})
+	ctx := context.Background()
+
+	submit := func(req dto.Request) (*dto.Request, error) {
+		return s.SubmitCleaningRequest(ctx, &req)
+	}
+	first := dto.Request{Id: 1, ClientId: 7, Priority: 2}
+	queued := dto.Request{Id: 2, ClientId: 7}
+
+	if _, err := submit(first); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := submit(queued); err != nil {
+		t.Fatal(err)
+	}
+
+	// Retries of request in progress and of queued one return their states and change nothing
+	for _, retry := range []struct {
+		req    dto.Request
+		status dto.RequestStatus
+	}{{first, dto.RequestInProgress}, {queued, dto.RequestQueued}} {
+		got, err := submit(retry.req)
+		if err != nil {
+			t.Fatalf("retry of request %d: %v", retry.req.Id, err)
+		}
+		if want := requestOf(t, s, retry.req.Id); got.Status != retry.status || !reflect.DeepEqual(got, want) {
+			t.Fatalf("retry of request %d returned %+v, want %+v", retry.req.Id, got, want)
+		}
+	}
+	if st := statsOf(t, s); st.Arrivals != 2 || st.Started != 1 || st.QueueLength != 1 || st.Rejected != 0 {
+		t.Fatalf("arrivals = %d, started = %d, queue length = %d, rejected = %d, want 2, 1, 1 and 0",
+			st.Arrivals, st.Started, st.QueueLength, st.Rejected)
+	}
+
+	// Request with the same id and other payload is not a retry
+	conflicting := first
+	conflicting.Priority = 1
+	if _, err := submit(conflicting); !errors.Is(err, ErrRequestExists) {
+		t.Fatalf("conflicting submission's error = %v, want %v", err, ErrRequestExists)
+	}
+
+	// Rejected request is submitted anew, when it is retried
+	rejected := dto.Request{Id: 3, ClientId: 8}
+	if _, err := submit(rejected); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("submission to full queue: error = %v, want %v", err, ErrQueueFull)
+	}
+	clk.RunUntil(testStart.Add(requestOf(t, s, 1).TimeInCleaner))
+	got, err := submit(rejected)
+	if err != nil {
+		t.Fatalf("retry of rejected request: %v", err)
+	}
+	if got.Status != dto.RequestQueued {
+		t.Fatalf("retried request's status = %d, want %d", got.Status, dto.RequestQueued)
+	}
+	if st := statsOf(t, s); st.Arrivals != 4 || st.Rejected != 1 {
+		t.Fatalf("arrivals = %d, rejected = %d, want 4 and 1", st.Arrivals, st.Rejected)
+	}
+
+	// Completed request stays completed
+	clk.RunUntil(testStart.Add(24 * time.Hour))
+	got, err = submit(first)
+	if err != nil {
+		t.Fatalf("retry of completed request: %v", err)
+	}
+	if got.Status != dto.RequestCompleted {
+		t.Fatalf("completed request's retry returned status %d", got.Status)
+	}
+	if st := statsOf(t, s); st.Arrivals != 4 || st.Completed != 3 {
+		t.Fatalf("arrivals = %d, completed = %d, want 4 and 3", st.Arrivals, st.Completed)
+	}
+}
@@ -0,0 +1,31 @@
+package logic
+
+import (
+	"math/rand/v2"
+
+	"github.com/Bazhenator/cleaner/internal/clock"
+)
+
+// Option configures Service
+type Option func(*Service)
+
+// WithClock makes service run on given clock instead of the wall one
+func WithClock(clk clock.Clock) Option {
+	return func(s *Service) {
+		s.clock = clk
+	}
+}
+
+// WithRand makes service use given source of randomness, so its work is reproducible
+func WithRand(rng *rand.Rand) Option {
+	return func(s *Service) {
+		s.rng = rng
+	}
+}
+
+// WithTimelineCapacity makes service keep up to n latest intervals of teams' activity, every interval if n is zero
+func WithTimelineCapacity(n int) Option {
+	return func(s *Service) {
+		s.timeline = newTimeline(n)
+	}
+}
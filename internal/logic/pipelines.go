@@ -0,0 +1,173 @@
+package logic
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/entities"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// stage is a stage of cleaning pipeline
+type stage struct {
+	name   string
+	teams  map[uint64]bool // teams are stage's pool, nil if any team able to clean the type serves it
+	weight float64         // weight is a share of type's cleaning time stage takes
+}
+
+// stageStats accumulate statistics of stages with the same name
+type stageStats struct {
+	started        uint64
+	completed      uint64
+	totalWait      time.Duration // totalWait is a sum of waiting times of started cleanings
+	totalInCleaner time.Duration // totalInCleaner is a sum of cleaning times of stages, interrupted cleanings included
+}
+
+// newPipelines builds pipelines from configuration checking their teams exist
+func newPipelines(c configs.Pipelines, teams uint64) (map[uint][]*stage, error) {
+	pipelines := make(map[uint][]*stage, len(c))
+	for cleaningType, stages := range c {
+		if len(stages) == 0 {
+			return nil, fmt.Errorf("pipeline of cleaning type %d has no stages", cleaningType)
+		}
+
+		for i, sc := range stages {
+			st := &stage{name: sc.Name, weight: sc.Weight}
+			if st.name == "" {
+				st.name = fmt.Sprintf("stage %d", i+1)
+			}
+			if st.weight < 0 {
+				return nil, fmt.Errorf("stage %q of cleaning type %d has negative weight", st.name, cleaningType)
+			}
+			if st.weight == 0 {
+				st.weight = 1
+			}
+			if len(sc.Teams) > 0 {
+				st.teams = make(map[uint64]bool, len(sc.Teams))
+			}
+			for _, id := range sc.Teams {
+				if id >= teams {
+					return nil, fmt.Errorf("stage %q of cleaning type %d has unknown team %d", st.name, cleaningType, id)
+				}
+				st.teams[id] = true
+			}
+			pipelines[cleaningType] = append(pipelines[cleaningType], st)
+		}
+	}
+
+	return pipelines, nil
+}
+
+// enterPipeline starts request's pipeline from its first stage
+func (s *Service) enterPipeline(req *dto.Request) {
+	req.Stage = 0
+	req.Stages = nil
+
+	pipeline := s.pipelines[req.CleaningType]
+	if len(pipeline) == 0 {
+		return
+	}
+
+	req.Stages = make([]dto.StageTime, 0, len(pipeline))
+	for _, st := range pipeline {
+		req.Stages = append(req.Stages, dto.StageTime{Name: st.name})
+	}
+}
+
+// stageOf returns request's current stage or nil if its type has no pipeline
+func (s *Service) stageOf(req *dto.Request) *stage {
+	pipeline := s.pipelines[req.CleaningType]
+	if req.Stage >= len(pipeline) {
+		return nil
+	}
+
+	return pipeline[req.Stage]
+}
+
+// canServe reports whether team is able to clean request's current stage
+func (s *Service) canServe(team *entities.CleaningTeam, req *dto.Request) bool {
+	if !team.CanClean(req.CleaningType) {
+		return false
+	}
+
+	if req.Pinned && team.Id != req.TeamId {
+		return false
+	}
+
+	st := s.stageOf(req)
+	return st == nil || st.teams == nil || st.teams[team.Id]
+}
+
+// servable reports whether every stage of request has a team able to clean it. Must be called under s.mu
+func (s *Service) servable(req *dto.Request) bool {
+	pipeline := s.pipelines[req.CleaningType]
+	if len(pipeline) == 0 {
+		return slices.ContainsFunc(s.teams, func(team *entities.CleaningTeam) bool { return team.CanClean(req.CleaningType) })
+	}
+
+	for _, st := range pipeline {
+		if !slices.ContainsFunc(s.teams, func(team *entities.CleaningTeam) bool {
+			return team.CanClean(req.CleaningType) && (st.teams == nil || st.teams[team.Id])
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// advance moves request, which current stage has been cleaned, to the next stage.
+// Returns false if request has passed its pipeline
+func (s *Service) advance(req *dto.Request) bool {
+	if req.Stage+1 >= len(req.Stages) {
+		return false
+	}
+	req.Stage++
+
+	return true
+}
+
+// trackStage returns statistics of request's current stage or nil if its type has no pipeline. Must be called under s.mu
+func (s *Service) trackStage(req *dto.Request) *stageStats {
+	if req.Stages == nil {
+		return nil
+	}
+
+	name := req.Stages[req.Stage].Name
+	st, ok := s.stats.stages[name]
+	if !ok {
+		st = &stageStats{}
+		s.stats.stages[name] = st
+	}
+
+	return st
+}
+
+// stagesStats returns statistics of pipeline stages ordered by name. Must be called under s.mu
+func (s *Service) stagesStats() []*dto.StageStats {
+	stages := make([]*dto.StageStats, 0, len(s.stats.stages))
+	for name, st := range s.stats.stages {
+		stage := &dto.StageStats{Name: name, Completed: st.completed}
+		if st.started > 0 {
+			stage.MeanWaitTime = st.totalWait / time.Duration(st.started)
+		}
+		if st.completed > 0 {
+			stage.MeanTimeInCleaner = st.totalInCleaner / time.Duration(st.completed)
+		}
+		stages = append(stages, stage)
+	}
+	slices.SortFunc(stages, func(a, b *dto.StageStats) int { return strings.Compare(a.Name, b.Name) })
+
+	return stages
+}
+
+// snapshot returns copy of request, which is safe to pass outside of s.mu
+func snapshot(req *dto.Request) *dto.Request {
+	c := *req
+	c.Stages = slices.Clone(req.Stages)
+
+	return &c
+}
@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"fmt"
+
+	"math/rand/v2"
+
+	"github.com/Bazhenator/cleaner/internal/entities"
+)
+
+// TeamSelector chooses a team for automatically assigned request among available ones
+type TeamSelector func(rng *rand.Rand, available []*entities.CleaningTeam) *entities.CleaningTeam
+
+// Team-selection policies of automatic assignment
+const (
+	PolicyFirst     = "first"
+	PolicyRandom    = "random"
+	PolicyFastest   = "fastest"
+	PolicyLeastBusy = "least-busy"
+)
+
+// NewTeamSelector returns team selector by its policy name
+func NewTeamSelector(policy string) (TeamSelector, error) {
+	switch policy {
+	case PolicyFirst:
+		return func(_ *rand.Rand, available []*entities.CleaningTeam) *entities.CleaningTeam {
+			return available[0]
+		}, nil
+	case PolicyRandom:
+		return func(rng *rand.Rand, available []*entities.CleaningTeam) *entities.CleaningTeam {
+			return available[rng.IntN(len(available))]
+		}, nil
+	case PolicyFastest:
+		return minTeam(func(a, b *entities.CleaningTeam) bool {
+			return a.Speed < b.Speed
+		}), nil
+	case PolicyLeastBusy:
+		return minTeam(func(a, b *entities.CleaningTeam) bool {
+			return a.TotalBusyTime < b.TotalBusyTime
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown team-selection policy %q", policy)
+	}
+}
+
+// minTeam creates selector, which chooses the first of teams with minimal value by less
+func minTeam(less func(a, b *entities.CleaningTeam) bool) TeamSelector {
+	return func(_ *rand.Rand, available []*entities.CleaningTeam) *entities.CleaningTeam {
+		best := available[0]
+		for _, team := range available[1:] {
+			if less(team, best) {
+				best = team
+			}
+		}
+
+		return best
+	}
+}
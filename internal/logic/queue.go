@@ -0,0 +1,196 @@
+package logic
+
+import (
+	"container/heap"
+	"slices"
+
+	"github.com/Bazhenator/cleaner/internal/clock"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// queuedRequest is a request waiting for a free team
+type queuedRequest struct {
+	req    *dto.Request
+	seq    uint64      // seq keeps FIFO order among requests with equal priority
+	tag    float64     // tag is a virtual finish time of request under fair queueing
+	index  int         // index is a position in heap, -1 after request has left the queue
+	renege clock.Timer // renege drops request when its patience runs out, nil if request is patient
+}
+
+// requestQueue is a priority queue of requests. Requests with greater priority are served first,
+// requests with equal priority are served in arrival order or by fair queueing across clients
+type requestQueue struct {
+	items   []*queuedRequest
+	nextSeq uint64
+	counts  map[uint]uint64   // counts are amounts of waiting requests of each priority
+	clients map[uint64]uint64 // clients are amounts of waiting requests of each client
+	fair    *fairQueueing     // fair is nil if requests with equal priority are served in arrival order
+}
+
+// fairQueueing is a self-clocked weighted fair queueing: clients get shares of dispatched requests in proportion to their weights
+type fairQueueing struct {
+	weights  map[uint64]float64 // weights are clients' shares by client id, 1 by default
+	virtual  float64            // virtual is a tag of the latest dispatched request
+	finishes map[uint64]float64 // finishes are tags of clients' latest queued requests
+}
+
+func newRequestQueue() *requestQueue {
+	return &requestQueue{
+		counts:  make(map[uint]uint64),
+		clients: make(map[uint64]uint64),
+	}
+}
+
+// serveFairly makes requests with equal priority be served by weighted fair queueing across clients
+func (q *requestQueue) serveFairly(weights map[uint64]float64) {
+	q.fair = &fairQueueing{weights: weights, finishes: make(map[uint64]float64)}
+}
+
+// push puts request to the queue
+func (q *requestQueue) push(req *dto.Request) *queuedRequest {
+	item := &queuedRequest{req: req, seq: q.nextSeq}
+	if q.fair != nil {
+		item.tag = q.fair.tag(req.ClientId)
+	}
+	heap.Push(q, item)
+	q.nextSeq++
+	q.counts[req.Priority]++
+	q.clients[req.ClientId]++
+
+	return item
+}
+
+// pop takes the most urgent request from the queue. Returns nil if queue is empty
+func (q *requestQueue) pop() *dto.Request {
+	if len(q.items) == 0 {
+		return nil
+	}
+
+	item := heap.Pop(q).(*queuedRequest)
+	q.serve(item)
+	q.leave(item)
+
+	return item.req
+}
+
+// popFirst takes the most urgent request, which satisfies match. Returns nil if there is no such request
+func (q *requestQueue) popFirst(match func(*dto.Request) bool) *dto.Request {
+	if len(q.items) == 0 {
+		return nil
+	}
+	if match(q.items[0].req) {
+		return q.pop()
+	}
+
+	ordered := slices.Clone(q.items)
+	slices.SortFunc(ordered, func(a, b *queuedRequest) int {
+		if q.less(a, b) {
+			return -1
+		}
+		return 1
+	})
+	for _, item := range ordered {
+		if match(item.req) {
+			q.serve(item)
+			q.remove(item)
+			return item.req
+		}
+	}
+
+	return nil
+}
+
+// remove takes request out of the queue. Returns false if request has already left it
+func (q *requestQueue) remove(item *queuedRequest) bool {
+	if item.index < 0 {
+		return false
+	}
+
+	heap.Remove(q, item.index)
+	q.leave(item)
+
+	return true
+}
+
+func (q *requestQueue) leave(item *queuedRequest) {
+	q.counts[item.req.Priority]--
+	if item.renege != nil {
+		item.renege.Stop()
+	}
+
+	clientId := item.req.ClientId
+	if q.clients[clientId]--; q.clients[clientId] == 0 {
+		delete(q.clients, clientId)
+		if q.fair != nil && q.fair.finishes[clientId] <= q.fair.virtual {
+			delete(q.fair.finishes, clientId)
+		}
+	}
+}
+
+// serve advances fair queueing's virtual time to request dispatched to a team
+func (q *requestQueue) serve(item *queuedRequest) {
+	if q.fair != nil {
+		q.fair.virtual = max(q.fair.virtual, item.tag)
+	}
+}
+
+// count returns amount of waiting requests with given priority
+func (q *requestQueue) count(priority uint) uint64 {
+	return q.counts[priority]
+}
+
+// countOf returns amount of client's waiting requests
+func (q *requestQueue) countOf(clientId uint64) uint64 {
+	return q.clients[clientId]
+}
+
+// tag returns virtual finish time of client's new request, client's backlog delays it
+func (f *fairQueueing) tag(clientId uint64) float64 {
+	weight, ok := f.weights[clientId]
+	if !ok {
+		weight = 1
+	}
+
+	tag := max(f.virtual, f.finishes[clientId]) + 1/weight
+	f.finishes[clientId] = tag
+
+	return tag
+}
+
+// Len, Less, Swap, Push and Pop implement heap.Interface, use push and pop instead
+
+func (q *requestQueue) Len() int { return len(q.items) }
+
+func (q *requestQueue) Less(i, j int) bool { return q.less(q.items[i], q.items[j]) }
+
+func (q *requestQueue) less(a, b *queuedRequest) bool {
+	if a.req.Priority != b.req.Priority {
+		return a.req.Priority > b.req.Priority
+	}
+	if a.tag != b.tag {
+		return a.tag < b.tag
+	}
+
+	return a.seq < b.seq
+}
+
+func (q *requestQueue) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.items[i].index = i
+	q.items[j].index = j
+}
+
+func (q *requestQueue) Push(x any) {
+	item := x.(*queuedRequest)
+	item.index = len(q.items)
+	q.items = append(q.items, item)
+}
+
+func (q *requestQueue) Pop() any {
+	last := q.items[len(q.items)-1]
+	q.items[len(q.items)-1] = nil
+	q.items = q.items[:len(q.items)-1]
+	last.index = -1
+
+	return last
+}
@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+func TestQueueOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights map[uint64]float64 // weights are nil if queue serves requests in arrival order
+		pushed  []*dto.Request
+		want    []uint64 // want are ids of requests in order of popping
+	}{
+		{
+			name:   "priority then arrival",
+			pushed: []*dto.Request{{Id: 1, Priority: 1}, {Id: 2, Priority: 2}, {Id: 3, Priority: 1}, {Id: 4, Priority: 2}},
+			want:   []uint64{2, 4, 1, 3},
+		},
+		{
+			name:    "equal weights alternate clients",
+			weights: map[uint64]float64{},
+			pushed: []*dto.Request{
+				{Id: 1, ClientId: 1}, {Id: 2, ClientId: 1}, {Id: 3, ClientId: 1}, {Id: 4, ClientId: 2}, {Id: 5, ClientId: 2},
+			},
+			want: []uint64{1, 4, 2, 5, 3},
+		},
+		{
+			name:    "client of double weight gets two of three",
+			weights: map[uint64]float64{1: 2},
+			pushed: []*dto.Request{
+				{Id: 1, ClientId: 1}, {Id: 2, ClientId: 1}, {Id: 3, ClientId: 1}, {Id: 4, ClientId: 1},
+				{Id: 5, ClientId: 2}, {Id: 6, ClientId: 2},
+			},
+			want: []uint64{1, 2, 5, 3, 4, 6},
+		},
+		{
+			name:    "priority precedes fairness",
+			weights: map[uint64]float64{},
+			pushed:  []*dto.Request{{Id: 1, ClientId: 1}, {Id: 2, ClientId: 1}, {Id: 3, ClientId: 2, Priority: 1}},
+			want:    []uint64{3, 1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newRequestQueue()
+			if tt.weights != nil {
+				q.serveFairly(tt.weights)
+			}
+			for _, req := range tt.pushed {
+				q.push(req)
+			}
+
+			var got []uint64
+			for req := q.pop(); req != nil; req = q.pop() {
+				got = append(got, req.Id)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("popped %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFairQueueingDoesNotCreditIdleClient(t *testing.T) {
+	q := newRequestQueue()
+	q.serveFairly(nil)
+	for id := uint64(1); id <= 4; id++ {
+		q.push(&dto.Request{Id: id, ClientId: 1})
+	}
+	q.pop()
+	q.pop()
+
+	// Client, which has been idle while others were served, joins at the current virtual time
+	q.push(&dto.Request{Id: 5, ClientId: 2})
+
+	var got []uint64
+	for req := q.pop(); req != nil; req = q.pop() {
+		got = append(got, req.Id)
+	}
+	if want := []uint64{3, 5, 4}; !slices.Equal(got, want) {
+		t.Fatalf("popped %v, want %v", got, want)
+	}
+}
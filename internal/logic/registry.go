@@ -0,0 +1,100 @@
+package logic
+
+import (
+	"fmt"
+
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// registryCapacity is a max amount of finished requests kept in registry, the earliest finished ones are forgotten first
+const registryCapacity = 1 << 16
+
+// requestRegistry keeps every request known to service by its id
+type requestRegistry struct {
+	requests map[uint64]*dto.Request
+	finished []*dto.Request // finished are finished requests in order of finishing
+	payloads map[uint64]payload
+}
+
+// payload is a part of request given by client, repeated submissions of request carry the same payload
+type payload struct {
+	clientId     uint64
+	cleaningType uint
+	priority     uint
+	patience     int64
+	deadline     int64
+	location     dto.Location
+	located      bool
+}
+
+func newRequestRegistry() *requestRegistry {
+	return &requestRegistry{
+		requests: make(map[uint64]*dto.Request),
+		payloads: make(map[uint64]payload),
+	}
+}
+
+// add registers request, request with the same id is replaced
+func (r *requestRegistry) add(req *dto.Request) {
+	r.requests[req.Id] = req
+	r.payloads[req.Id] = payloadOf(req)
+}
+
+// get returns registered request. Returns false if there is no such request
+func (r *requestRegistry) get(id uint64) (*dto.Request, bool) {
+	req, ok := r.requests[id]
+	return req, ok
+}
+
+// repeated returns request with the same id, which is still being served or has been completed.
+// Returns nil if request is new or its previous submission has failed, so it may be submitted again
+func (r *requestRegistry) repeated(req *dto.Request) (*dto.Request, error) {
+	existing, ok := r.requests[req.Id]
+	if !ok {
+		return nil, nil
+	}
+	switch existing.Status {
+	case dto.RequestQueued, dto.RequestInProgress, dto.RequestScheduled, dto.RequestCompleted:
+	default:
+		return nil, nil
+	}
+
+	if r.payloads[req.Id] != payloadOf(req) {
+		return nil, fmt.Errorf("%w: request %d has different payload", ErrRequestExists, req.Id)
+	}
+
+	return existing, nil
+}
+
+// finish remembers that request has left service and forgets the earliest finished requests over capacity
+func (r *requestRegistry) finish(req *dto.Request) {
+	r.finished = append(r.finished, req)
+	if len(r.finished) <= registryCapacity {
+		return
+	}
+
+	earliest := r.finished[0]
+	r.finished[0] = nil
+	r.finished = r.finished[1:]
+	if r.requests[earliest.Id] == earliest {
+		delete(r.requests, earliest.Id)
+		delete(r.payloads, earliest.Id)
+	}
+}
+
+func payloadOf(req *dto.Request) payload {
+	p := payload{
+		clientId:     req.ClientId,
+		cleaningType: req.CleaningType,
+		priority:     req.Priority,
+		patience:     int64(req.Patience),
+	}
+	if !req.Deadline.IsZero() {
+		p.deadline = req.Deadline.UnixNano()
+	}
+	if req.Location != nil {
+		p.location, p.located = *req.Location, true
+	}
+
+	return p
+}
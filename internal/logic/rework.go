@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// inspect checks quality of completed request. Request, which has failed inspection, is queued for rework.
+// Returns true if request has passed. Must be called under s.mu
+func (s *Service) inspect(req *dto.Request) bool {
+	c := s.c.Rework
+	if c == nil || (c.MaxIterations > 0 && req.Reworks >= c.MaxIterations) || s.rng.Float64() >= c.Probability {
+		return true
+	}
+
+	// The team, which has cleaned reworked stage, is blamed for failure
+	teamId := req.TeamId
+	if req.Stages != nil {
+		req.Stage = reworkStage(req, c.Stage)
+		teamId = req.Stages[req.Stage].TeamId
+	}
+	team := s.teams[teamId]
+	team.Reworks++
+
+	req.Reworks++
+	req.Priority += c.PriorityBoost
+	if c.SameTeam {
+		req.TeamId = teamId
+		req.Pinned = true
+	}
+
+	s.stats.reworked++
+	s.publish(dto.EventRequestReworked, team, req)
+	s.requeue(req)
+
+	return false
+}
+
+// reworkStage returns index of request's pipeline stage named name, the first stage if there is no such stage
+func reworkStage(req *dto.Request, name string) int {
+	for i, st := range req.Stages {
+		if st.Name == name {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// reworkRate returns share of inspections failed
+func reworkRate(reworks, inspections uint64) float64 {
+	if inspections == 0 {
+		return 0
+	}
+
+	return float64(reworks) / float64(inspections)
+}
@@ -0,0 +1,291 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/clock"
+	"github.com/Bazhenator/cleaner/internal/entities"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	"github.com/Bazhenator/tools/src/logger"
+)
+
+// ScheduleCleaningRequest books the earliest team's slot for request starting inside the time window.
+// Cleaning starts automatically at booked moment. Returns request with booked team and start
+func (s *Service) ScheduleCleaningRequest(ctx context.Context, in *dto.ScheduleCleaningRequestIn) (*dto.ScheduleCleaningRequestOut, error) {
+	if in.Request == nil {
+		s.l.ErrorCtx(ctx, "Request came nil", logger.NewErrorField(ErrNilRequest))
+		return nil, ErrNilRequest
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Retried booking gets the slot booked by the first one
+	existing, err := s.registry.repeated(in.Request)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &dto.ScheduleCleaningRequestOut{Req: snapshot(existing)}, nil
+	}
+
+	now := s.clock.Now()
+	earliest := in.EarliestStart
+	if earliest.Before(now) {
+		earliest = now
+	}
+	if in.LatestStart.Before(earliest) {
+		return nil, fmt.Errorf("%w: latest start %s is before %s", ErrInvalidWindow, in.LatestStart.Format(time.RFC3339), earliest.Format(time.RFC3339))
+	}
+
+	req := in.Request
+	if err := s.checkLocation(req); err != nil {
+		return nil, err
+	}
+	s.enterPipeline(req)
+	if !s.servable(req) {
+		return nil, fmt.Errorf("%w: no team cleans type %d", ErrTeamIneligible, req.CleaningType)
+	}
+
+	req.SubmittedAt = now
+	s.stats.arrive(req)
+	s.registry.add(req)
+	s.publish(dto.EventRequestSubmitted, nil, req)
+	if err := s.throttle(req); err != nil {
+		return nil, err
+	}
+
+	if !s.book(req, earliest, in.LatestStart, nil) {
+		s.reject(req)
+		return nil, fmt.Errorf("%w: from %s till %s", ErrNoFreeSlot, earliest.Format(time.RFC3339), in.LatestStart.Format(time.RFC3339))
+	}
+	s.stats.scheduled++
+
+	return &dto.ScheduleCleaningRequestOut{Req: snapshot(req)}, nil
+}
+
+// GetFreeSlots finds teams' intervals, cleaning of the type may be booked in.
+// Returns free slots of every team able to clean the type
+func (s *Service) GetFreeSlots(ctx context.Context, in *dto.GetFreeSlotsIn) (*dto.GetFreeSlotsOut, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	from := in.From
+	if now := s.clock.Now(); from.Before(now) {
+		from = now
+	}
+	if !in.To.After(from) {
+		return nil, fmt.Errorf("%w: %s is not after %s", ErrInvalidWindow, in.To.Format(time.RFC3339), from.Format(time.RFC3339))
+	}
+
+	req := &dto.Request{CleaningType: in.CleaningType}
+	s.enterPipeline(req)
+
+	out := &dto.GetFreeSlotsOut{}
+	for _, team := range s.teams {
+		if !s.canServe(team, req) {
+			continue
+		}
+
+		duration := s.estimate(team, req)
+		for _, window := range s.freeWindows(team, from, in.To, duration) {
+			out.Slots = append(out.Slots, &dto.FreeSlot{
+				TeamId:   team.Id,
+				Start:    window.Start,
+				End:      window.End,
+				Duration: duration,
+			})
+		}
+	}
+
+	return out, nil
+}
+
+// booking is a scheduled request's wait for its start
+type booking struct {
+	team  *entities.CleaningTeam // team is nil if request is queued at its start without reservation
+	timer clock.Timer
+}
+
+// book reserves the earliest team's slot for request starting inside [earliest, latest], preferred team's slot goes first.
+// Returns false if there is no free slot. Must be called under s.mu
+func (s *Service) book(req *dto.Request, earliest, latest time.Time, preferred *uint64) bool {
+	team, start, ok := s.findSlot(req, earliest, latest, preferred)
+	if !ok {
+		return false
+	}
+
+	team.Timeline.Reserve(&entities.Reservation{
+		RequestId: req.Id,
+		Interval:  entities.Interval{Start: start, End: start.Add(s.estimate(team, req))},
+	})
+	s.hold(team, req, start, latest)
+
+	return true
+}
+
+// hold makes request wait till start for booked team or for the queue if team is nil. Must be called under s.mu
+func (s *Service) hold(team *entities.CleaningTeam, req *dto.Request, start, latest time.Time) {
+	req.Status = dto.RequestScheduled
+	req.ScheduledAt = start
+	if team != nil {
+		req.TeamId = team.Id
+	}
+	if req.Deadline.IsZero() || latest.Before(req.Deadline) {
+		req.Deadline = latest
+	}
+	s.publish(dto.EventRequestScheduled, team, req)
+
+	s.bookings[req] = &booking{
+		team: team,
+		timer: s.clock.AfterFunc(start.Sub(s.clock.Now()), func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			s.startScheduled(req)
+		}),
+	}
+}
+
+// unbook cancels request's booking. Returns false if request does not wait for its start. Must be called under s.mu
+func (s *Service) unbook(req *dto.Request) bool {
+	b, ok := s.bookings[req]
+	if !ok {
+		return false
+	}
+
+	b.timer.Stop()
+	if b.team != nil {
+		b.team.Timeline.Cancel(req.Id)
+	}
+	delete(s.bookings, req)
+
+	return true
+}
+
+// startScheduled starts cleaning of scheduled request by its booked team.
+// Request waits for any team till its deadline, if booked team is not free. Must be called under s.mu
+func (s *Service) startScheduled(req *dto.Request) {
+	team := s.bookings[req].team
+	s.unbook(req)
+	req.EnqueuedAt = s.clock.Now()
+
+	// Booked slot is checked since its start, so that timer's latency does not overlap the next reservation
+	if team != nil && team.Status == entities.Available && s.canServe(team, req) && s.fits(team, req, req.ScheduledAt) {
+		s.startCleaning(team, req)
+		return
+	}
+
+	req.Status = dto.RequestQueued
+	s.enqueue(req)
+}
+
+// findSlot finds team able to start cleaning request the earliest inside [earliest, latest], preferred team wins if it is able at all.
+// Returns false if there is no such team. Must be called under s.mu
+func (s *Service) findSlot(req *dto.Request, earliest, latest time.Time, preferred *uint64) (*entities.CleaningTeam, time.Time, bool) {
+	var found *entities.CleaningTeam
+	var start time.Time
+	for _, team := range s.teams {
+		if !s.canServe(team, req) {
+			continue
+		}
+
+		duration := s.estimate(team, req)
+		windows := s.freeWindows(team, earliest, latest.Add(duration), duration)
+		if len(windows) == 0 || windows[0].Start.After(latest) {
+			continue
+		}
+		if preferred != nil && team.Id == *preferred {
+			return team, windows[0].Start, true
+		}
+		if found == nil || windows[0].Start.Before(start) {
+			found, start = team, windows[0].Start
+		}
+	}
+
+	return found, start, found != nil
+}
+
+// freeWindows returns team's working intervals inside [from, to), which are neither booked nor occupied
+// by running activities and fit cleaning of duration. Must be called under s.mu
+func (s *Service) freeWindows(team *entities.CleaningTeam, from, to time.Time, duration time.Duration) []entities.Interval {
+	free := team.Timeline.Free(from, to, team.Capacity, s.occupiedSlots(team)...)
+	if s.schedules != nil {
+		var working []entities.Interval
+		for _, in := range s.schedules[team.Id].WorkingIntervals(from, to) {
+			working = append(working, entities.Interval{Start: in.Start, End: in.End})
+		}
+		free = intersect(free, working)
+	}
+
+	windows := make([]entities.Interval, 0, len(free))
+	for _, in := range free {
+		if in.End.Sub(in.Start) >= duration {
+			windows = append(windows, in)
+		}
+	}
+
+	return windows
+}
+
+// occupiedSlots returns intervals team's slots are occupied by running cleanings and return trips for.
+// Cleaning is expected to end after its travel and estimated duration, overdue one is not expected to hold its slot.
+// Must be called under s.mu
+func (s *Service) occupiedSlots(team *entities.CleaningTeam) []entities.Interval {
+	now := s.clock.Now()
+	busy := make([]entities.Interval, 0, len(team.Requests)+len(team.Trips))
+	for _, req := range team.Requests {
+		end := req.StartedAt.Add(s.estimate(team, req))
+		if c, ok := s.cleanings[req]; ok {
+			end = end.Add(c.travel)
+		}
+		busy = append(busy, entities.Interval{Start: now, End: end})
+	}
+	for _, trip := range team.Trips {
+		busy = append(busy, entities.Interval{Start: now, End: trip.ArrivesAt})
+	}
+
+	return busy
+}
+
+// estimate returns time booked for team's cleaning of request's current stage
+func (s *Service) estimate(team *entities.CleaningTeam, req *dto.Request) time.Duration {
+	duration := team.MeanCleaningTimeOf(s.c.BaseSpeed, req.CleaningType)
+	if st := s.stageOf(req); st != nil {
+		duration = time.Duration(float64(duration) * st.weight)
+	}
+
+	return duration
+}
+
+// fits reports whether team is able to clean request's current stage since now without breaking its reservations
+func (s *Service) fits(team *entities.CleaningTeam, req *dto.Request, now time.Time) bool {
+	return team.CanTake(now, now.Add(s.estimate(team, req)))
+}
+
+// intersect returns intersection of two ordered lists of disjoint intervals
+func intersect(a, b []entities.Interval) []entities.Interval {
+	var out []entities.Interval
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := a[i].Start, a[i].End
+		if b[j].Start.After(start) {
+			start = b[j].Start
+		}
+		if b[j].End.Before(end) {
+			end = b[j].End
+		}
+		if start.Before(end) {
+			out = append(out, entities.Interval{Start: start, End: end})
+		}
+
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return out
+}
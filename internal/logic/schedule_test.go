@@ -0,0 +1,118 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// testEstimate is a time booked for cleaning by slow team of scheduleConfig
+const testEstimate = 60 * time.Second
+
+func scheduleConfig(capacity uint64) *configs.Config {
+	return &configs.Config{TeamsAmount: 1, TeamsSpeeds: []uint64{3}, TeamsCapacities: []uint64{capacity}}
+}
+
+func TestScheduleBooksTeamsSlots(t *testing.T) {
+	at := testStart.Add(time.Hour)
+	tests := []struct {
+		name     string
+		capacity uint64
+		windows  [][2]time.Time // windows are bookings' earliest and latest starts in order of booking
+		starts   []time.Time    // starts are booked starts, zero if booking conflicts
+	}{
+		{
+			name:     "overlapping bookings of one slot",
+			capacity: 1,
+			windows:  [][2]time.Time{{at, at}, {at, at}, {at.Add(-testEstimate / 2), at}},
+			starts:   []time.Time{at, {}, {}},
+		},
+		{
+			name:     "the next free moment of one slot",
+			capacity: 1,
+			windows:  [][2]time.Time{{at, at}, {at, at.Add(time.Hour)}, {at.Add(-time.Hour), at.Add(time.Hour)}},
+			starts:   []time.Time{at, at.Add(testEstimate), at.Add(-time.Hour)},
+		},
+		{
+			name:     "overlapping bookings of two slots",
+			capacity: 2,
+			windows:  [][2]time.Time{{at, at}, {at, at}, {at, at}},
+			starts:   []time.Time{at, at, {}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestService(t, scheduleConfig(tt.capacity))
+
+			for i, window := range tt.windows {
+				out, err := s.ScheduleCleaningRequest(context.Background(), &dto.ScheduleCleaningRequestIn{
+					Request:       &dto.Request{Id: uint64(i + 1)},
+					EarliestStart: window[0],
+					LatestStart:   window[1],
+				})
+				if tt.starts[i].IsZero() {
+					if !errors.Is(err, ErrNoFreeSlot) {
+						t.Fatalf("booking %d: error = %v, want %v", i, err, ErrNoFreeSlot)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("booking %d: %v", i, err)
+				}
+				if !out.Req.ScheduledAt.Equal(tt.starts[i]) {
+					t.Fatalf("booking %d starts at %s, want %s", i, out.Req.ScheduledAt, tt.starts[i])
+				}
+			}
+		})
+	}
+}
+
+func TestScheduledRequestStartsAtBookedTime(t *testing.T) {
+	s, clk := newTestService(t, scheduleConfig(1))
+	at := testStart.Add(time.Hour)
+	if _, err := s.ScheduleCleaningRequest(context.Background(), &dto.ScheduleCleaningRequestIn{
+		Request:       &dto.Request{Id: 1},
+		EarliestStart: at,
+		LatestStart:   at,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Team takes requests submitted meanwhile, if they are expected to end before booking
+	if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if req := requestOf(t, s, 2); req.Status != dto.RequestInProgress {
+		t.Fatalf("submitted request's status = %d, want %d", req.Status, dto.RequestInProgress)
+	}
+
+	clk.RunUntil(at.Add(-time.Nanosecond))
+	if req := requestOf(t, s, 1); req.Status != dto.RequestScheduled {
+		t.Fatalf("status = %d before booked start, want %d", req.Status, dto.RequestScheduled)
+	}
+	clk.RunUntil(at)
+	if req := requestOf(t, s, 1); req.Status != dto.RequestInProgress || !req.StartedAt.Equal(at) || req.WaitTime != 0 {
+		t.Fatalf("status = %d, started at %s, wait time = %s, want %d at %s without waiting",
+			req.Status, req.StartedAt, req.WaitTime, dto.RequestInProgress, at)
+	}
+}
+
+func TestFreeSlotsExcludeRunningCleaning(t *testing.T) {
+	s, _ := newTestService(t, scheduleConfig(1))
+	if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := s.GetFreeSlots(context.Background(), &dto.GetFreeSlotsIn{From: testStart, To: testStart.Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Slots) != 1 || !out.Slots[0].Start.Equal(testStart.Add(testEstimate)) || out.Slots[0].Duration != testEstimate {
+		t.Fatalf("free slots = %+v, want one since end of running cleaning", out.Slots)
+	}
+}
@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/calendar"
+	"github.com/Bazhenator/cleaner/internal/entities"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// recheckShiftsAfter is a delay of checking schedule again, when it has no changes ahead
+const recheckShiftsAfter = 7 * 24 * time.Hour
+
+// initShifts puts teams on or off shift according to their schedules and follows schedules' changes
+func (s *Service) initShifts(c *configs.CalendarConfig) error {
+	schedules, err := calendar.NewSchedules(c, uint64(len(s.teams)))
+	if err != nil {
+		return err
+	}
+	s.schedules = schedules
+
+	now := s.clock.Now()
+	for _, team := range s.teams {
+		if !s.schedules[team.Id].IsWorking(now) {
+			team.EndShift(now)
+		}
+		s.scheduleShiftChange(team, now)
+	}
+
+	return nil
+}
+
+// scheduleShiftChange schedules team's next shift start or end
+func (s *Service) scheduleShiftChange(team *entities.CleaningTeam, now time.Time) {
+	next, ok := s.schedules[team.Id].NextChange(now)
+	if !ok {
+		next = now.Add(recheckShiftsAfter)
+	}
+
+	s.clock.AfterFunc(next.Sub(now), func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.changeShift(team)
+	})
+}
+
+// changeShift starts or ends team's shift following its schedule. Must be called under s.mu
+func (s *Service) changeShift(team *entities.CleaningTeam) {
+	now := s.clock.Now()
+	working := s.schedules[team.Id].IsWorking(now)
+
+	switch {
+	case working && !team.OnShift:
+		team.StartShift(now)
+		s.publish(dto.EventShiftStarted, team, nil)
+		s.dispatch()
+	case !working && team.OnShift:
+		team.EndShift(now)
+		if s.c.Calendar.Overtime == configs.OvertimeHandoff {
+			s.cancelCleanings(team)
+			for _, req := range team.Handoff(now) {
+				s.stats.handoffs++
+				s.requeue(req)
+			}
+		}
+		s.publish(dto.EventShiftEnded, team, nil)
+	}
+
+	s.scheduleShiftChange(team, now)
+}
+
+// onShiftUtilization returns share of slots' shift time they have been busy, overtime excluded
+func onShiftUtilization(team *entities.CleaningTeam, now time.Time) float64 {
+	shiftTime := team.TotalShiftTime + team.ShiftTimeSince(now)
+	if shiftTime <= 0 {
+		return 0
+	}
+
+	busy := team.TotalBusyTime + team.BusyTimeSince(now) - team.TotalOvertimeBusy - team.OvertimeBusySince(now)
+
+	return busy.Seconds() / (shiftTime.Seconds() * float64(team.Capacity))
+}
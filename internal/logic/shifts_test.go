@@ -0,0 +1,133 @@
+package logic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// shiftEnd is the end of the first day shift after testStart
+var shiftEnd = time.Date(2024, time.January, 1, 17, 0, 0, 0, time.UTC)
+
+// calendarConfig gives teams schedules by name: "day" shift ends at shiftEnd and the "evening" one starts then
+func calendarConfig(overtime string, teams ...string) *configs.CalendarConfig {
+	return &configs.CalendarConfig{
+		Overtime: overtime,
+		Schedules: map[string]*configs.ScheduleConfig{
+			"day":     {Shifts: []*configs.PeriodConfig{{Start: "09:00", End: "17:00"}}},
+			"evening": {Shifts: []*configs.PeriodConfig{{Start: "17:00", End: "23:00"}}},
+		},
+		Teams: teams,
+	}
+}
+
+// cleaningTimes returns durations of n cleanings in order service of config starts them.
+// Durations don't depend on moments of cleanings, so they tell in advance when cleanings end
+func cleaningTimes(t *testing.T, c configs.Config, n int) []time.Duration {
+	t.Helper()
+
+	c.Calendar = nil
+	s, clk := newTestService(t, &c)
+	for i := range n {
+		if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: uint64(i + 1)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clk.Run()
+
+	durations := make([]time.Duration, 0, n)
+	for i := range n {
+		durations = append(durations, requestOf(t, s, uint64(i+1)).TimeInCleaner)
+	}
+
+	return durations
+}
+
+func teamStatsOf(t *testing.T, s *Service, teamId uint64) *dto.TeamStats {
+	t.Helper()
+
+	out, err := s.GetTeamsStats(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return out.Stats[teamId]
+}
+
+func TestShiftEndsMidCleaning(t *testing.T) {
+	tests := []struct {
+		name     string
+		overtime string
+		teams    []string
+		team     uint64        // team is a team, which finishes cleaning
+		resumed  time.Duration // resumed is a time since shiftEnd cleaning is resumed after
+		handoffs uint64
+
+		overtimeHalves int // overtimeHalves is an overtime of the day team in halves of cleaning
+	}{
+		{name: "finish in overtime", overtime: configs.OvertimeFinish, teams: []string{"day"}, team: 0, overtimeHalves: 1},
+		{name: "handoff to evening team", overtime: configs.OvertimeHandoff, teams: []string{"day", "evening"}, team: 1, handoffs: 1},
+		{name: "handoff to the next shift of the same team", overtime: configs.OvertimeHandoff, teams: []string{"day"}, team: 0, resumed: 16 * time.Hour, handoffs: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := configs.Config{TeamsAmount: uint64(len(tt.teams)), Calendar: calendarConfig(tt.overtime, tt.teams...)}
+			d := cleaningTimes(t, c, 1)[0]
+			s, clk := newTestService(t, &c)
+
+			// Cleaning is half done at the end of shift
+			clk.RunUntil(shiftEnd.Add(-d / 2))
+			if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: 1}); err != nil {
+				t.Fatal(err)
+			}
+			clk.RunUntil(shiftEnd.Add(tt.resumed))
+
+			req := requestOf(t, s, 1)
+			if req.TeamId != tt.team || req.WaitTime != tt.resumed {
+				t.Fatalf("team = %d, wait time = %s, want %d and %s", req.TeamId, req.WaitTime, tt.team, tt.resumed)
+			}
+			if tt.handoffs > 0 {
+				// Handed off request is charged for the half done and for the whole cleaning done anew
+				if req.Status != dto.RequestInProgress || req.TimeInCleaner <= d/2 {
+					t.Fatalf("status = %d, time in cleaner = %s, want cleaning resumed after %s", req.Status, req.TimeInCleaner, d/2)
+				}
+			}
+
+			clk.RunUntil(shiftEnd.Add(tt.resumed + req.TimeInCleaner))
+			if req := requestOf(t, s, 1); req.Status != dto.RequestCompleted {
+				t.Fatalf("status = %d, want completed", req.Status)
+			}
+
+			day := teamStatsOf(t, s, 0)
+			if want := time.Duration(tt.overtimeHalves) * (d / 2); day.Overtime != want || day.Handoffs != tt.handoffs {
+				t.Fatalf("overtime = %s, handoffs = %d, want %s and %d", day.Overtime, day.Handoffs, want, tt.handoffs)
+			}
+		})
+	}
+}
+
+func TestHandedOffRequestRenegesOnRemainingPatience(t *testing.T) {
+	c := configs.Config{TeamsAmount: 1, Calendar: calendarConfig(configs.OvertimeHandoff, "day")}
+	durations := cleaningTimes(t, c, 2)
+	s, clk := newTestService(t, &c)
+
+	// The second request waits for the first one, then its cleaning is half done at the end of shift
+	clk.RunUntil(shiftEnd.Add(-durations[0] - durations[1]/2))
+	ctx := context.Background()
+	if _, err := s.SubmitCleaningRequest(ctx, &dto.Request{Id: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SubmitCleaningRequest(ctx, &dto.Request{Id: 2, Patience: durations[0] + time.Hour}); err != nil {
+		t.Fatal(err)
+	}
+	clk.RunUntil(shiftEnd.Add(2 * time.Hour))
+
+	req := requestOf(t, s, 2)
+	if finished := shiftEnd.Add(time.Hour); req.Status != dto.RequestReneged || !req.FinishedAt.Equal(finished) {
+		t.Fatalf("status = %d, finished at %s, want %d at %s", req.Status, req.FinishedAt, dto.RequestReneged, finished)
+	}
+}
@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// systemStats accumulates service-wide statistics since the moment they were reset
+type systemStats struct {
+	since         time.Time
+	arrivals      uint64
+	started       uint64
+	completed     uint64
+	rejected      uint64
+	reneged       uint64
+	balked        uint64
+	scheduled     uint64
+	canceled      uint64
+	interrupted   uint64 // interrupted is an amount of cleanings interrupted by teams' breakdowns
+	failed        uint64
+	handoffs      uint64        // handoffs is an amount of cleanings left unfinished at teams' shifts' end
+	reworked      uint64        // reworked is an amount of inspections failed by completed requests
+	totalWait     time.Duration // totalWait is a sum of waiting times of started requests
+	totalTravel   time.Duration // totalTravel is a sum of teams' travel times to started requests
+	totalResponse time.Duration // totalResponse is a sum of sojourn times of completed requests
+	queueArea     float64       // queueArea is an integral of queue length over time, in request-seconds
+	queueChanged  time.Time
+	maxQueue      uint64
+	stages        map[string]*stageStats  // stages are statistics of pipeline stages by name
+	clients       map[uint64]*clientStats // clients are statistics of clients' requests by client id
+}
+
+// clientStats accumulates statistics of client's requests
+type clientStats struct {
+	arrivals      uint64
+	completed     uint64
+	rejected      uint64 // rejected is an amount of requests rejected, balked or rate limited
+	reneged       uint64
+	totalWait     time.Duration // totalWait is a sum of waiting times of completed requests
+	totalCleaning time.Duration // totalCleaning is a sum of cleaning times of completed requests
+	totalResponse time.Duration
+}
+
+func newSystemStats(now time.Time) *systemStats {
+	return &systemStats{
+		since:        now,
+		queueChanged: now,
+		stages:       make(map[string]*stageStats),
+		clients:      make(map[uint64]*clientStats),
+	}
+}
+
+// arrive counts request's arrival
+func (st *systemStats) arrive(req *dto.Request) {
+	st.arrivals++
+	st.client(req.ClientId).arrivals++
+}
+
+// leave counts request, which has left service, in statistics of its client
+func (st *systemStats) leave(req *dto.Request) {
+	cs := st.client(req.ClientId)
+	switch req.Status {
+	case dto.RequestCompleted:
+		cs.completed++
+		cs.totalWait += req.WaitTime
+		cs.totalCleaning += req.TimeInCleaner
+		cs.totalResponse += req.FinishedAt.Sub(req.SubmittedAt)
+	case dto.RequestRejected, dto.RequestBalked:
+		cs.rejected++
+	case dto.RequestReneged:
+		cs.reneged++
+	}
+}
+
+// client returns statistics of client's requests
+func (st *systemStats) client(clientId uint64) *clientStats {
+	cs, ok := st.clients[clientId]
+	if !ok {
+		cs = &clientStats{}
+		st.clients[clientId] = cs
+	}
+
+	return cs
+}
+
+// trackQueue must be called before every queue length change with its previous length
+func (st *systemStats) trackQueue(now time.Time, length int) {
+	st.queueArea += float64(length) * now.Sub(st.queueChanged).Seconds()
+	st.queueChanged = now
+}
+
+// queueArea returns integral of queue length till now
+func (st *systemStats) queueAreaAt(now time.Time, length int) float64 {
+	return st.queueArea + float64(length)*now.Sub(st.queueChanged).Seconds()
+}
@@ -0,0 +1,363 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/clock"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	"github.com/Bazhenator/cleaner/internal/recurrence"
+	"github.com/Bazhenator/tools/src/logger"
+)
+
+// maxOccurrences is an amount of subscription's occurrences, which numbers fit the low half of requests' ids
+const maxOccurrences = 1 << 32
+
+// subscription is a client's recurring cleaning with its known occurrences
+type subscription struct {
+	*dto.Subscription
+
+	rule        *recurrence.Rule
+	occurrences map[uint64]*dto.Occurrence // occurrences are booked, skipped and rescheduled occurrences by number
+	cursor      *recurrence.Cursor         // cursor is at the first occurrence, which is not booked yet
+	next        *dto.Occurrence            // next is an occurrence taken from cursor waiting for horizon
+	lastTeam    *uint64                    // lastTeam is a team booked for the latest occurrence
+	timer       clock.Timer                // timer books the next occurrence when it enters horizon
+}
+
+// initSubscriptions creates predefined subscriptions
+func (s *Service) initSubscriptions(c *configs.SubscriptionsConfig) error {
+	if c.Horizon > 0 {
+		s.horizon = time.Duration(c.Horizon * float64(time.Second))
+	}
+
+	for _, sc := range c.Subscriptions {
+		sub := &dto.Subscription{
+			Id:           sc.Id,
+			ClientId:     sc.ClientId,
+			CleaningType: sc.CleaningType,
+			Priority:     sc.Priority,
+			Rule:         sc.Rule,
+			Start:        sc.Start,
+			Window:       time.Duration(sc.Window * float64(time.Second)),
+			SameTeam:     sc.SameTeam,
+		}
+		if sc.Location != nil {
+			loc := dto.Location(*sc.Location)
+			sub.Location = &loc
+		}
+
+		if _, err := s.subscribe(sub); err != nil {
+			return fmt.Errorf("invalid subscription %d: %w", sc.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateSubscription creates client's recurring cleaning. Occurrences are booked as they enter service's horizon.
+// Returns subscription with occurrences booked at once
+func (s *Service) CreateSubscription(ctx context.Context, in *dto.Subscription) (*dto.Subscription, error) {
+	if in == nil {
+		return nil, ErrNilRequest
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, err := s.subscribe(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return sub.snapshot(), nil
+}
+
+// GetSubscription gets subscription with its booked, skipped and rescheduled occurrences
+func (s *Service) GetSubscription(ctx context.Context, id uint64) (*dto.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, ok := s.subscriptions[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrSubscriptionNotFound, id)
+	}
+
+	return sub.snapshot(), nil
+}
+
+// CancelSubscription stops subscription, its booked occurrences, which have not started yet, are canceled
+func (s *Service) CancelSubscription(ctx context.Context, id uint64) (*dto.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, ok := s.subscriptions[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrSubscriptionNotFound, id)
+	}
+	if sub.Canceled {
+		return sub.snapshot(), nil
+	}
+
+	sub.Canceled = true
+	if sub.timer != nil {
+		sub.timer.Stop()
+	}
+	for _, occ := range sub.occurrences {
+		if occ.Request != nil && s.unbook(occ.Request) {
+			s.cancel(occ.Request)
+		}
+	}
+
+	return sub.snapshot(), nil
+}
+
+// SkipOccurrence cancels single occurrence of subscription, occurrence, which is not booked yet, is never booked
+func (s *Service) SkipOccurrence(ctx context.Context, in *dto.OccurrenceIn) (*dto.Occurrence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, occ, err := s.occurrence(in)
+	if err != nil {
+		return nil, err
+	}
+	if occ.Skipped {
+		return snapshotOccurrence(occ), nil
+	}
+
+	if occ.Request != nil {
+		if !s.unbook(occ.Request) {
+			return nil, fmt.Errorf("%w: occurrence %d of subscription %d", ErrOccurrenceClosed, occ.Number, sub.Id)
+		}
+		s.cancel(occ.Request)
+	}
+	occ.Skipped = true
+	sub.occurrences[occ.Number] = occ
+
+	return snapshotOccurrence(occ), nil
+}
+
+// RescheduleOccurrence moves single occurrence of subscription to another start, occurrence is booked again at once
+func (s *Service) RescheduleOccurrence(ctx context.Context, in *dto.OccurrenceIn) (*dto.Occurrence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, occ, err := s.occurrence(in)
+	if err != nil {
+		return nil, err
+	}
+	if in.Start.Before(s.clock.Now()) {
+		return nil, fmt.Errorf("%w: new start %s has passed", ErrInvalidWindow, in.Start.Format(time.RFC3339))
+	}
+	if occ.Skipped || (occ.Request != nil && !s.unbook(occ.Request)) {
+		return nil, fmt.Errorf("%w: occurrence %d of subscription %d", ErrOccurrenceClosed, occ.Number, sub.Id)
+	}
+
+	occ.Start = in.Start
+	if occ.Request == nil {
+		if err := s.occur(sub, occ); err != nil {
+			return nil, err
+		}
+	} else {
+		occ.Request.TeamId = 0
+		occ.Request.Deadline = time.Time{}
+		s.bookOccurrence(sub, occ)
+	}
+
+	return snapshotOccurrence(occ), nil
+}
+
+// subscribe validates subscription and books its occurrences entering horizon. Must be called under s.mu
+func (s *Service) subscribe(in *dto.Subscription) (*subscription, error) {
+	// Id makes the high half of occurrences' requests' ids, zero one would be taken for ad-hoc requests' one
+	if in.Id == 0 || in.Id > math.MaxUint32 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidSubscription, in.Id)
+	}
+	if _, ok := s.subscriptions[in.Id]; ok {
+		return nil, fmt.Errorf("%w: %d", ErrSubscriptionExists, in.Id)
+	}
+
+	rule, err := recurrence.Parse(in.Rule)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRule, err)
+	}
+
+	req := &dto.Request{CleaningType: in.CleaningType, Location: in.Location}
+	if err := s.checkLocation(req); err != nil {
+		return nil, err
+	}
+	s.enterPipeline(req)
+	if !s.servable(req) {
+		return nil, fmt.Errorf("%w: no team cleans type %d", ErrTeamIneligible, in.CleaningType)
+	}
+
+	if in.Start.IsZero() {
+		in.Start = s.clock.Now()
+	}
+	in.Canceled = false
+	in.Occurrences = nil
+
+	sub := &subscription{
+		Subscription: in,
+		rule:         rule,
+		occurrences:  make(map[uint64]*dto.Occurrence),
+		cursor:       rule.Cursor(in.Start),
+	}
+	s.subscriptions[in.Id] = sub
+	s.materialize(sub)
+
+	return sub, nil
+}
+
+// materialize books subscription's occurrences starting till horizon and plans booking of the next one.
+// Occurrences, which have passed before subscription's creation, are never booked. Must be called under s.mu
+func (s *Service) materialize(sub *subscription) {
+	now := s.clock.Now()
+	horizon := now.Add(s.horizon)
+	for {
+		if sub.next == nil {
+			n, start, ok := sub.cursor.Next()
+			if !ok || n >= maxOccurrences {
+				return
+			}
+			sub.next = &dto.Occurrence{Number: n, Start: start}
+		}
+
+		if sub.next.Start.After(horizon) {
+			sub.timer = s.clock.AfterFunc(sub.next.Start.Sub(horizon), func() {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+
+				s.materialize(sub)
+			})
+			return
+		}
+
+		occ := sub.next
+		sub.next = nil
+		if _, ok := sub.occurrences[occ.Number]; ok || occ.Start.Before(now) {
+			continue
+		}
+		if err := s.occur(sub, occ); err != nil {
+			s.l.Warn("subscription's occurrence is skipped", logger.NewErrorField(err))
+		}
+	}
+}
+
+// occur registers occurrence's request and books it. Returns error and leaves occurrence unbooked,
+// if its request's id is taken by another request. Must be called under s.mu
+func (s *Service) occur(sub *subscription, occ *dto.Occurrence) error {
+	id := occurrenceId(sub.Id, occ.Number)
+	if _, ok := s.registry.get(id); ok {
+		return fmt.Errorf("%w: %d of occurrence %d of subscription %d", ErrRequestExists, id, occ.Number, sub.Id)
+	}
+
+	req := &dto.Request{
+		Id:             id,
+		ClientId:       sub.ClientId,
+		CleaningType:   sub.CleaningType,
+		Priority:       sub.Priority,
+		SubmittedAt:    s.clock.Now(),
+		SubscriptionId: sub.Id,
+	}
+	if sub.Location != nil {
+		loc := *sub.Location
+		req.Location = &loc
+	}
+	s.enterPipeline(req)
+
+	occ.Request = req
+	sub.occurrences[occ.Number] = occ
+	s.stats.arrive(req)
+	s.stats.scheduled++
+	s.registry.add(req)
+	s.publish(dto.EventRequestSubmitted, nil, req)
+
+	s.bookOccurrence(sub, occ)
+	return nil
+}
+
+// bookOccurrence books occurrence's request, the team of the previous occurrence is preferred if subscription asks so.
+// Occurrence without free slot joins the queue at its start. Must be called under s.mu
+func (s *Service) bookOccurrence(sub *subscription, occ *dto.Occurrence) {
+	var preferred *uint64
+	if sub.SameTeam {
+		preferred = sub.lastTeam
+	}
+
+	req := occ.Request
+	latest := occ.Start.Add(sub.Window)
+	if !s.book(req, occ.Start, latest, preferred) {
+		s.hold(nil, req, occ.Start, latest)
+		return
+	}
+
+	teamId := req.TeamId
+	sub.lastTeam = &teamId
+}
+
+// occurrence finds subscription's occurrence, occurrence, which is not booked yet, is returned without request.
+// Must be called under s.mu
+func (s *Service) occurrence(in *dto.OccurrenceIn) (*subscription, *dto.Occurrence, error) {
+	sub, ok := s.subscriptions[in.SubscriptionId]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %d", ErrSubscriptionNotFound, in.SubscriptionId)
+	}
+	if sub.Canceled {
+		return nil, nil, fmt.Errorf("%w: %d", ErrSubscriptionCanceled, sub.Id)
+	}
+
+	if occ, ok := sub.occurrences[in.Number]; ok {
+		return sub, occ, nil
+	}
+
+	if in.Number >= maxOccurrences {
+		return nil, nil, fmt.Errorf("%w: occurrence %d of subscription %d", ErrOccurrenceNotFound, in.Number, sub.Id)
+	}
+	start, ok := sub.rule.Nth(sub.Start, in.Number)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: occurrence %d of subscription %d", ErrOccurrenceNotFound, in.Number, sub.Id)
+	}
+	if start.Before(s.clock.Now()) {
+		return nil, nil, fmt.Errorf("%w: occurrence %d of subscription %d", ErrOccurrenceClosed, in.Number, sub.Id)
+	}
+
+	return sub, &dto.Occurrence{Number: in.Number, Start: start}, nil
+}
+
+// cancel finishes scheduled request, which will never be cleaned. Must be called under s.mu
+func (s *Service) cancel(req *dto.Request) {
+	s.stats.canceled++
+	s.finish(req, dto.RequestCanceled)
+	s.publish(dto.EventRequestCanceled, nil, req)
+}
+
+// snapshot returns copy of subscription with occurrences in order of number
+func (sub *subscription) snapshot() *dto.Subscription {
+	out := *sub.Subscription
+	out.Occurrences = make([]*dto.Occurrence, 0, len(sub.occurrences))
+	for _, n := range slices.Sorted(maps.Keys(sub.occurrences)) {
+		out.Occurrences = append(out.Occurrences, snapshotOccurrence(sub.occurrences[n]))
+	}
+
+	return &out
+}
+
+func snapshotOccurrence(occ *dto.Occurrence) *dto.Occurrence {
+	out := *occ
+	if occ.Request != nil {
+		out.Request = snapshot(occ.Request)
+	}
+
+	return &out
+}
+
+// occurrenceId returns id of occurrence's request: subscription's id in high 32 bits and occurrence's number in low ones
+func occurrenceId(subscriptionId, number uint64) uint64 {
+	return subscriptionId<<32 | number
+}
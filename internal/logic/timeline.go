@@ -0,0 +1,110 @@
+package logic
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// timelineCapacity is a default max amount of intervals kept in timeline, the earliest recorded ones are forgotten first
+const timelineCapacity = 1 << 16
+
+// timeline keeps intervals of teams' activity. Interval is recorded with its planned end when activity starts,
+// so it may end in future until activity is cut short
+type timeline struct {
+	intervals []*dto.Interval // intervals are in order of recording
+	capacity  int             // capacity is zero if every interval is kept
+}
+
+func newTimeline(capacity int) *timeline {
+	return &timeline{capacity: capacity}
+}
+
+// add records team's activity planned from start to end
+func (t *timeline) add(teamId, requestId uint64, kind dto.ActivityKind, start, end time.Time) *dto.Interval {
+	iv := &dto.Interval{
+		TeamId:    teamId,
+		RequestId: requestId,
+		Kind:      kind,
+		Start:     start,
+		End:       end,
+	}
+	t.intervals = append(t.intervals, iv)
+
+	if t.capacity > 0 && len(t.intervals) > t.capacity {
+		t.intervals[0] = nil
+		t.intervals = t.intervals[1:]
+	}
+
+	return iv
+}
+
+// cut ends interval at now if it is planned to end later. Interval, which has not started yet, becomes empty
+func cut(iv *dto.Interval, now time.Time) {
+	if iv.End.After(now) {
+		iv.End = now
+	}
+	if iv.Start.After(iv.End) {
+		iv.Start = iv.End
+	}
+}
+
+// abort cuts team's activities of given kind at now
+func (t *timeline) abort(teamId uint64, kind dto.ActivityKind, now time.Time) {
+	for _, iv := range t.intervals {
+		if iv.TeamId == teamId && iv.Kind == kind {
+			cut(iv, now)
+		}
+	}
+}
+
+// get returns copies of intervals, which have started by now and overlap the requested period.
+// Intervals in progress end now
+func (t *timeline) get(in *dto.GetTimelineIn, now time.Time) []*dto.Interval {
+	to := now
+	if !in.To.IsZero() && in.To.Before(now) {
+		to = in.To
+	}
+
+	var out []*dto.Interval
+	for _, iv := range t.intervals {
+		if in.TeamId != nil && iv.TeamId != *in.TeamId {
+			continue
+		}
+		if !iv.End.After(iv.Start) || iv.Start.After(to) || (!in.From.IsZero() && !iv.End.After(in.From)) {
+			continue
+		}
+
+		c := *iv
+		if c.End.After(now) {
+			c.End, c.Ongoing = now, true
+		}
+		out = append(out, &c)
+	}
+
+	slices.SortStableFunc(out, func(a, b *dto.Interval) int {
+		return cmp.Or(a.Start.Compare(b.Start), cmp.Compare(a.TeamId, b.TeamId))
+	})
+
+	return out
+}
+
+// GetTimeline returns intervals of teams' activity: travels, cleanings, return trips and repairs
+func (s *Service) GetTimeline(ctx context.Context, in *dto.GetTimelineIn) (*dto.GetTimelineOut, error) {
+	if !in.From.IsZero() && !in.To.IsZero() && !in.From.Before(in.To) {
+		return nil, fmt.Errorf("%w: %s is not after %s", ErrInvalidWindow, in.To.Format(time.RFC3339), in.From.Format(time.RFC3339))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if in.TeamId != nil && *in.TeamId >= uint64(len(s.teams)) {
+		return nil, fmt.Errorf("%w: team %d", ErrTeamNotFound, *in.TeamId)
+	}
+
+	return &dto.GetTimelineOut{Intervals: s.timeline.get(in, s.clock.Now())}, nil
+}
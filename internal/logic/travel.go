@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/entities"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	"github.com/Bazhenator/cleaner/internal/travel"
+)
+
+// initTravel creates travel-time model and places teams at their bases
+func (s *Service) initTravel(c *configs.TravelConfig) error {
+	model, err := travel.NewModel(c)
+	if err != nil {
+		return err
+	}
+	s.travel = model
+
+	if len(c.Bases) == 0 {
+		return nil
+	}
+	for _, team := range s.teams {
+		base := dto.Location(c.Bases[min(team.Id, uint64(len(c.Bases)-1))])
+		if err := model.Validate(base); err != nil {
+			return fmt.Errorf("invalid base of team %d: %w", team.Id, err)
+		}
+		team.Base = base
+		team.Position = base
+	}
+
+	return nil
+}
+
+// checkLocation checks whether request's location is known to travel model
+func (s *Service) checkLocation(req *dto.Request) error {
+	if s.travel == nil || req.Location == nil {
+		return nil
+	}
+	if err := s.travel.Validate(*req.Location); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidLocation, err)
+	}
+
+	return nil
+}
+
+// goTo sends team to request's location. Returns travel time, zero if request has no location
+func (s *Service) goTo(team *entities.CleaningTeam, req *dto.Request) time.Duration {
+	if s.travel == nil || req.Location == nil {
+		return 0
+	}
+
+	travelTime := s.travel.Time(team.Position, *req.Location)
+	team.Position = *req.Location
+
+	return travelTime
+}
+
+// returnToBase sends team's slot, which has cleaned request, back to base according to travel policy.
+// Must be called under s.mu
+func (s *Service) returnToBase(team *entities.CleaningTeam, req *dto.Request) {
+	if s.travel == nil || req.Location == nil || s.c.Travel.Policy != configs.TravelReturn {
+		return
+	}
+
+	travelTime := s.travel.Time(*req.Location, team.Base)
+	if travelTime <= 0 {
+		team.Position = team.Base
+		return
+	}
+
+	now := s.clock.Now()
+	trip := team.StartReturn(now, now.Add(travelTime))
+	s.timeline.add(team.Id, req.Id, dto.ActivityReturn, now, now.Add(travelTime))
+	s.clock.AfterFunc(travelTime, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if team.EndReturn(trip, s.clock.Now()) {
+			s.publish(dto.EventTeamReturned, team, nil)
+			s.dispatch()
+		}
+	})
+}
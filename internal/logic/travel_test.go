@@ -0,0 +1,100 @@
+package logic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// testTrip is a travel time of team from its base to testLocation
+const testTrip = 100 * time.Second
+
+var testLocation = &dto.Location{X: 60, Y: 80}
+
+func travelConfig(policy string) *configs.TravelConfig {
+	return &configs.TravelConfig{Model: configs.TravelEuclidean, Policy: policy, Bases: []configs.Location{{}}}
+}
+
+func TestRequestIsChargedForTravel(t *testing.T) {
+	s, clk := newTestService(t, &configs.Config{TeamsAmount: 1, Travel: travelConfig(configs.TravelReturn)})
+	if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: 1, Location: testLocation}); err != nil {
+		t.Fatal(err)
+	}
+
+	req := requestOf(t, s, 1)
+	finished := testStart.Add(testTrip + req.TimeInCleaner)
+	if req.TravelTime != testTrip {
+		t.Fatalf("travel time = %s, want %s", req.TravelTime, testTrip)
+	}
+
+	clk.RunUntil(finished)
+	if req := requestOf(t, s, 1); req.Status != dto.RequestCompleted || !req.FinishedAt.Equal(finished) {
+		t.Fatalf("status = %d, finished at %s, want %d at %s", req.Status, req.FinishedAt, dto.RequestCompleted, finished)
+	}
+
+	// Team's slot is busy on the way back to base
+	clk.RunUntil(finished.Add(testTrip))
+	if team := teamStatsOf(t, s, 0); team.TravelTime != 2*testTrip {
+		t.Fatalf("team's travel time = %s, want %s", team.TravelTime, 2*testTrip)
+	}
+}
+
+func TestBreakdownCutsTripAndCleaning(t *testing.T) {
+	tests := []struct {
+		name      string
+		failAfter func(d time.Duration) time.Duration
+		travelled time.Duration
+		cleaned   func(d time.Duration) time.Duration
+	}{
+		{
+			name:      "on the way",
+			failAfter: func(time.Duration) time.Duration { return 40 * time.Second },
+			travelled: 40 * time.Second,
+			cleaned:   func(time.Duration) time.Duration { return 0 },
+		},
+		{
+			name:      "on arrival",
+			failAfter: func(time.Duration) time.Duration { return testTrip },
+			travelled: testTrip,
+			cleaned:   func(time.Duration) time.Duration { return 0 },
+		},
+		{
+			name:      "mid-cleaning",
+			failAfter: func(d time.Duration) time.Duration { return testTrip + d/2 },
+			travelled: testTrip,
+			cleaned:   func(d time.Duration) time.Duration { return d / 2 },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, clk := newTestService(t, &configs.Config{
+				TeamsAmount: 1,
+				Breakdowns:  breakdownsConfig(configs.BreakdownRequeue),
+				Travel:      travelConfig(configs.TravelChain),
+			})
+			if _, err := s.SubmitCleaningRequest(context.Background(), &dto.Request{Id: 1, Location: testLocation}); err != nil {
+				t.Fatal(err)
+			}
+			d := requestOf(t, s, 1).TimeInCleaner
+
+			clk.RunUntil(testStart.Add(tt.failAfter(d)))
+			failTeam(s, 0)
+
+			req := requestOf(t, s, 1)
+			if req.Status != dto.RequestQueued || req.TravelTime != tt.travelled || req.TimeInCleaner != tt.cleaned(d) {
+				t.Fatalf("status = %d, travel time = %s, time in cleaner = %s, want %d, %s and %s",
+					req.Status, req.TravelTime, req.TimeInCleaner, dto.RequestQueued, tt.travelled, tt.cleaned(d))
+			}
+			if team := teamStatsOf(t, s, 0); team.TravelTime != tt.travelled {
+				t.Fatalf("team's travel time = %s, want %s", team.TravelTime, tt.travelled)
+			}
+			if st := statsOf(t, s); st.TravelTime != tt.travelled {
+				t.Fatalf("total travel time = %s, want %s", st.TravelTime, tt.travelled)
+			}
+		})
+	}
+}
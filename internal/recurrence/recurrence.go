@@ -0,0 +1,270 @@
+// Package recurrence expands RRULE-style recurrences of clients' subscriptions
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+// Frequency is a length of rule's period
+type Frequency uint
+
+const (
+	Minutely Frequency = iota + 1
+	Hourly
+	Daily
+	Weekly
+	Monthly
+)
+
+var frequencies = map[string]Frequency{
+	"MINUTELY": Minutely,
+	"HOURLY":   Hourly,
+	"DAILY":    Daily,
+	"WEEKLY":   Weekly,
+	"MONTHLY":  Monthly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// untilLayout is a date-time format of UNTIL part, RFC 3339 is accepted too
+const untilLayout = "20060102T150405Z"
+
+const (
+	// maxPeriods limits how far occurrences are found, it is about two million years of minutely rule
+	maxPeriods = 1 << 40
+	// gregorianMonths are months of 400 years, Gregorian calendar repeats after
+	gregorianMonths = 400 * 12
+)
+
+// Rule is a recurrence repeating the first occurrence's time every Interval periods
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	Count    uint64         // Count is a max amount of occurrences, unlimited if zero
+	Until    time.Time      // Until is the latest occurrence's start, unlimited if zero
+	ByDay    []time.Weekday // ByDay are weekdays of weekly rule's occurrences ordered from Monday, the first occurrence's weekday if empty
+}
+
+// Parse parses rule like "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=10".
+// Supported parts are FREQ, INTERVAL, COUNT, UNTIL and BYDAY of weekly rules
+func Parse(s string) (*Rule, error) {
+	r := &Rule{Interval: 1}
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(s), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: part %q is not NAME=VALUE", ErrInvalidRule, part)
+		}
+
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			if r.Freq, ok = frequencies[strings.ToUpper(value)]; !ok {
+				err = fmt.Errorf("unknown frequency %q", value)
+			}
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err == nil && r.Interval < 1 {
+				err = errors.New("interval must be positive")
+			}
+		case "COUNT":
+			r.Count, err = strconv.ParseUint(value, 10, 64)
+		case "UNTIL":
+			if r.Until, err = time.Parse(untilLayout, value); err != nil {
+				r.Until, err = time.Parse(time.RFC3339, value)
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := weekdays[strings.ToUpper(day)]
+				if !ok {
+					err = fmt.Errorf("unknown weekday %q", day)
+					break
+				}
+				r.ByDay = append(r.ByDay, weekday)
+			}
+		default:
+			err = errors.New("unsupported part")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidRule, name, err)
+		}
+	}
+
+	if r.Freq == 0 {
+		return nil, fmt.Errorf("%w: FREQ is not defined", ErrInvalidRule)
+	}
+	if len(r.ByDay) > 0 && r.Freq != Weekly {
+		return nil, fmt.Errorf("%w: BYDAY is supported by weekly rules only", ErrInvalidRule)
+	}
+	slices.SortFunc(r.ByDay, func(a, b time.Weekday) int { return fromMonday(a) - fromMonday(b) })
+	r.ByDay = slices.Compact(r.ByDay)
+
+	return r, nil
+}
+
+// Cursor walks rule's occurrences in order of time
+type Cursor struct {
+	rule    *Rule
+	start   time.Time
+	period  int         // period is an index of the next period to expand
+	pending []time.Time // pending are occurrences of expanded period, which are not walked yet
+	n       uint64      // n is a number of the next occurrence
+}
+
+// Cursor returns cursor at rule's first occurrence starting at start
+func (r *Rule) Cursor(start time.Time) *Cursor {
+	return &Cursor{rule: r, start: start}
+}
+
+// Next returns number and start of the next occurrence. Returns false if rule has no more occurrences
+func (c *Cursor) Next() (uint64, time.Time, bool) {
+	for len(c.pending) == 0 {
+		c.pending = c.rule.period(c.start, c.period)
+		c.period += c.rule.Interval
+	}
+
+	t := c.pending[0]
+	c.pending = c.pending[1:]
+	if t.Before(c.start) {
+		return c.Next()
+	}
+	if (c.rule.Count > 0 && c.n >= c.rule.Count) || (!c.rule.Until.IsZero() && t.After(c.rule.Until)) {
+		c.pending = nil
+		return 0, time.Time{}, false
+	}
+
+	c.n++
+	return c.n - 1, t, true
+}
+
+// Nth returns start of rule's n-th occurrence since the first one at start. Returns false if rule ends earlier.
+// Occurrence's period is computed rather than walked to, so a distant occurrence costs as much as a near one
+func (r *Rule) Nth(start time.Time, n uint64) (time.Time, bool) {
+	if r.Count > 0 && n >= r.Count {
+		return time.Time{}, false
+	}
+
+	k, i, ok := r.locate(start, n)
+	if !ok {
+		return time.Time{}, false
+	}
+	t := r.period(start, k)[i]
+	if !r.Until.IsZero() && t.After(r.Until) {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// locate returns index of period since start and index inside period of rule's n-th occurrence.
+// Returns false if the occurrence or its period is further than maxPeriods
+func (r *Rule) locate(start time.Time, n uint64) (int, int, bool) {
+	if n > maxPeriods {
+		return 0, 0, false
+	}
+
+	var j uint64 // j is an index of the period among periods walked, which are Interval periods apart
+	var i int
+	switch {
+	case r.Freq == Weekly && len(r.ByDay) > 0:
+		// Days of the first week before start's weekday are skipped
+		skipped := slices.IndexFunc(r.ByDay, func(day time.Weekday) bool { return fromMonday(day) >= fromMonday(start.Weekday()) })
+		if skipped < 0 {
+			skipped = len(r.ByDay)
+		}
+		n += uint64(skipped)
+		j, i = n/uint64(len(r.ByDay)), int(n%uint64(len(r.ByDay)))
+	case r.Freq == Monthly && start.Day() > 28:
+		j = r.nthMonth(start, n)
+	default:
+		j = n
+	}
+
+	if j > maxPeriods/uint64(r.Interval) {
+		return 0, 0, false
+	}
+
+	return int(j) * r.Interval, i, true
+}
+
+// nthMonth returns index of walked period of monthly rule's n-th occurrence, when some months lack start's day.
+// Months of Gregorian calendar repeat every 400 years, so do walked periods having start's day
+func (r *Rule) nthMonth(start time.Time, n uint64) uint64 {
+	interval := r.Interval % gregorianMonths
+	cycle := gregorianMonths / gcd(gregorianMonths, interval)
+	var having []uint64
+	for j := range cycle {
+		if len(r.period(start, j*interval%gregorianMonths)) > 0 {
+			having = append(having, uint64(j))
+		}
+	}
+
+	// Start's month always has start's day, so a cycle has at least one occurrence
+	return n/uint64(len(having))*uint64(cycle) + having[n%uint64(len(having))]
+}
+
+// period returns occurrences of k-th period since start
+func (r *Rule) period(start time.Time, k int) []time.Time {
+	switch r.Freq {
+	case Minutely:
+		return []time.Time{after(start, k, time.Minute)}
+	case Hourly:
+		return []time.Time{after(start, k, time.Hour)}
+	case Daily:
+		return []time.Time{start.AddDate(0, 0, k)}
+	case Monthly:
+		// Months without start's day have no occurrence
+		if t := start.AddDate(0, k, 0); t.Day() == start.Day() {
+			return []time.Time{t}
+		}
+		return nil
+	}
+
+	if len(r.ByDay) == 0 {
+		return []time.Time{start.AddDate(0, 0, 7*k)}
+	}
+
+	monday := start.AddDate(0, 0, 7*k-fromMonday(start.Weekday()))
+	occurrences := make([]time.Time, 0, len(r.ByDay))
+	for _, day := range r.ByDay {
+		occurrences = append(occurrences, monday.AddDate(0, 0, fromMonday(day)))
+	}
+
+	return occurrences
+}
+
+// after returns moment k units after t. Unlike time.Duration, it doesn't overflow in 292 years
+func after(t time.Time, k int, unit time.Duration) time.Time {
+	return time.Unix(t.Unix()+int64(k)*int64(unit/time.Second), int64(t.Nanosecond())).In(t.Location())
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// fromMonday returns amount of days since Monday
+func fromMonday(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
@@ -0,0 +1,243 @@
+package recurrence
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02 15:04", s)
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		rule string
+		want Rule
+	}{
+		{"FREQ=DAILY", Rule{Freq: Daily, Interval: 1}},
+		{"RRULE:FREQ=weekly;INTERVAL=2;BYDAY=TH,MO,TH", Rule{Freq: Weekly, Interval: 2, ByDay: []time.Weekday{time.Monday, time.Thursday}}},
+		{"FREQ=WEEKLY;BYDAY=SU,MO", Rule{Freq: Weekly, Interval: 1, ByDay: []time.Weekday{time.Monday, time.Sunday}}},
+		{"FREQ=MONTHLY;COUNT=3", Rule{Freq: Monthly, Interval: 1, Count: 3}},
+		{"FREQ=HOURLY;UNTIL=20260105T120000Z", Rule{Freq: Hourly, Interval: 1, Until: date("2026-01-05 12:00")}},
+		{"FREQ=MINUTELY;UNTIL=2026-01-05T12:00:00Z", Rule{Freq: Minutely, Interval: 1, Until: date("2026-01-05 12:00")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			got, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Freq != tt.want.Freq || got.Interval != tt.want.Interval || got.Count != tt.want.Count ||
+				!got.Until.Equal(tt.want.Until) || !slices.Equal(got.ByDay, tt.want.ByDay) {
+				t.Fatalf("Parse(%q) = %+v, want %+v", tt.rule, *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=YEARLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=-1",
+		"FREQ=DAILY;UNTIL=tomorrow",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=DAILY;BYDAY=MO",
+		"FREQ=DAILY;BYMONTH=1",
+		"FREQ",
+	} {
+		if _, err := Parse(rule); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalidRule", rule, err)
+		}
+	}
+}
+
+func TestCursor(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  string
+		start string
+		limit int // limit is an amount of occurrences walked, rule must end before if zero
+		want  []string
+	}{
+		{
+			name: "count", rule: "FREQ=DAILY;INTERVAL=2;COUNT=3", start: "2026-01-30 09:00",
+			want: []string{"2026-01-30 09:00", "2026-02-01 09:00", "2026-02-03 09:00"},
+		},
+		{
+			name: "until is inclusive", rule: "FREQ=HOURLY;UNTIL=20260105T120000Z", start: "2026-01-05 10:00",
+			want: []string{"2026-01-05 10:00", "2026-01-05 11:00", "2026-01-05 12:00"},
+		},
+		{
+			name: "count ends before until", rule: "FREQ=MINUTELY;INTERVAL=15;COUNT=2;UNTIL=20260105T120000Z", start: "2026-01-05 10:00",
+			want: []string{"2026-01-05 10:00", "2026-01-05 10:15"},
+		},
+		{
+			name: "until ends before count", rule: "FREQ=DAILY;COUNT=10;UNTIL=20260106T000000Z", start: "2026-01-04 18:00",
+			want: []string{"2026-01-04 18:00", "2026-01-05 18:00"},
+		},
+		{
+			// 2026-01-07 is Wednesday, Monday of its week has passed
+			name: "weekly starting mid-week", rule: "FREQ=WEEKLY;BYDAY=MO,WE,FR", start: "2026-01-07 08:00", limit: 5,
+			want: []string{"2026-01-07 08:00", "2026-01-09 08:00", "2026-01-12 08:00", "2026-01-14 08:00", "2026-01-16 08:00"},
+		},
+		{
+			// Sunday ends week started on Monday, so it goes after Saturday and before the next Monday
+			name: "weekly across week boundary", rule: "FREQ=WEEKLY;BYDAY=SU,MO;COUNT=4", start: "2026-01-10 07:30",
+			want: []string{"2026-01-11 07:30", "2026-01-12 07:30", "2026-01-18 07:30", "2026-01-19 07:30"},
+		},
+		{
+			name: "biweekly", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH", start: "2026-01-08 12:00", limit: 4,
+			want: []string{"2026-01-08 12:00", "2026-01-20 12:00", "2026-01-22 12:00", "2026-02-03 12:00"},
+		},
+		{
+			name: "weekly without days repeats start's weekday", rule: "FREQ=WEEKLY;COUNT=3", start: "2026-01-07 08:00",
+			want: []string{"2026-01-07 08:00", "2026-01-14 08:00", "2026-01-21 08:00"},
+		},
+		{
+			name: "monthly on the 31st skips short months", rule: "FREQ=MONTHLY;COUNT=4", start: "2026-01-31 10:00",
+			want: []string{"2026-01-31 10:00", "2026-03-31 10:00", "2026-05-31 10:00", "2026-07-31 10:00"},
+		},
+		{
+			name: "monthly on the 29th in leap year", rule: "FREQ=MONTHLY;INTERVAL=12;COUNT=2", start: "2024-02-29 10:00",
+			want: []string{"2024-02-29 10:00", "2028-02-29 10:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			c := rule.Cursor(date(tt.start))
+			var got []string
+			for tt.limit == 0 || len(got) < tt.limit {
+				n, start, ok := c.Next()
+				if !ok {
+					break
+				}
+				if n != uint64(len(got)) {
+					t.Fatalf("occurrence %d is numbered %d", len(got), n)
+				}
+				got = append(got, start.Format("2006-01-02 15:04"))
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("occurrences %v, want %v", got, tt.want)
+			}
+			if tt.limit == 0 {
+				if _, _, ok := c.Next(); ok {
+					t.Fatal("ended rule has one more occurrence")
+				}
+			}
+		})
+	}
+}
+
+func TestNth(t *testing.T) {
+	rule, err := Parse("FREQ=WEEKLY;BYDAY=MO,FR;COUNT=5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := date("2026-01-07 08:00")
+
+	tests := []struct {
+		n    uint64
+		want string
+		ok   bool
+	}{
+		{0, "2026-01-09 08:00", true},
+		{1, "2026-01-12 08:00", true},
+		{3, "2026-01-19 08:00", true},
+		{4, "2026-01-23 08:00", true},
+		{5, "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := rule.Nth(start, tt.n)
+		if ok != tt.ok || (ok && got.Format("2006-01-02 15:04") != tt.want) {
+			t.Errorf("Nth(%d) = %s, %v, want %s, %v", tt.n, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestNthMatchesCursor(t *testing.T) {
+	tests := []struct {
+		rule  string
+		start string
+	}{
+		{"FREQ=MINUTELY;INTERVAL=45", "2026-01-05 10:00"},
+		{"FREQ=HOURLY;INTERVAL=5;UNTIL=20260110T000000Z", "2026-01-05 10:00"},
+		{"FREQ=DAILY;INTERVAL=3", "2026-01-30 09:00"},
+		{"FREQ=WEEKLY;INTERVAL=2", "2026-01-07 08:00"},
+		{"FREQ=WEEKLY;BYDAY=MO,WE,FR", "2026-01-07 08:00"},
+		{"FREQ=WEEKLY;BYDAY=MO,TU", "2026-01-10 08:00"},
+		{"FREQ=WEEKLY;INTERVAL=3;BYDAY=TU,TH,SU", "2026-01-08 12:00"},
+		{"FREQ=MONTHLY;INTERVAL=2", "2026-01-15 10:00"},
+		{"FREQ=MONTHLY", "2026-01-31 10:00"},
+		{"FREQ=MONTHLY;INTERVAL=5;COUNT=40", "2026-03-30 10:00"},
+		{"FREQ=MONTHLY;INTERVAL=12", "2024-02-29 10:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			start := date(tt.start)
+			c := rule.Cursor(start)
+			for n := uint64(0); n < 100; n++ {
+				_, want, wantOk := c.Next()
+				got, ok := rule.Nth(start, n)
+				if ok != wantOk || !got.Equal(want) {
+					t.Fatalf("Nth(%d) = %s, %v, want %s, %v", n, got, ok, want, wantOk)
+				}
+			}
+		})
+	}
+}
+
+func TestNthOfDistantOccurrence(t *testing.T) {
+	start := date("2026-01-31 10:00")
+	tests := []struct {
+		rule string
+		n    uint64
+		want time.Time
+		ok   bool
+	}{
+		{"FREQ=MINUTELY", 1<<32 - 1, start.AddDate(0, 0, (1<<32-1)/1440).Add((1<<32 - 1) % 1440 * time.Minute), true},
+		// 7 months of a year have the 31st
+		{"FREQ=MONTHLY", 7 * 400 * 1000, start.AddDate(400*1000, 0, 0), true},
+		{"FREQ=MONTHLY;COUNT=10", 1 << 32, time.Time{}, false},
+		{"FREQ=DAILY;UNTIL=20270101T000000Z", 1 << 32, time.Time{}, false},
+		{"FREQ=WEEKLY;BYDAY=MO,TU", 1<<64 - 1, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, ok := rule.Nth(start, tt.n)
+			if ok != tt.ok || (ok && !got.Equal(tt.want)) {
+				t.Fatalf("Nth(%d) = %s, %v, want %s, %v", tt.n, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package simulation
+
+import (
+	"math"
+)
+
+// Estimate is a point estimate of a metric across replications with its confidence interval
+type Estimate struct {
+	Mean      float64 `json:"mean"`
+	StdDev    float64 `json:"std_dev"`
+	HalfWidth float64 `json:"half_width"` // HalfWidth is a half of confidence interval's width
+	Low       float64 `json:"low"`
+	High      float64 `json:"high"`
+	N         int     `json:"n"`
+}
+
+// NewEstimate estimates mean of samples with Student's t confidence interval
+func NewEstimate(samples []float64, confidence float64) *Estimate {
+	e := &Estimate{N: len(samples)}
+	if e.N == 0 {
+		return e
+	}
+
+	for _, x := range samples {
+		e.Mean += x
+	}
+	e.Mean /= float64(e.N)
+
+	if e.N > 1 {
+		var ss float64
+		for _, x := range samples {
+			ss += (x - e.Mean) * (x - e.Mean)
+		}
+		e.StdDev = math.Sqrt(ss / float64(e.N-1))
+		e.HalfWidth = StudentQuantile(1-(1-confidence)/2, e.N-1) * e.StdDev / math.Sqrt(float64(e.N))
+	}
+
+	e.Low = e.Mean - e.HalfWidth
+	e.High = e.Mean + e.HalfWidth
+
+	return e
+}
+
+// StudentQuantile returns p-quantile of Student's t distribution with df degrees of freedom
+func StudentQuantile(p float64, df int) float64 {
+	if p == 0.5 {
+		return 0
+	}
+	if p < 0.5 {
+		return -StudentQuantile(1-p, df)
+	}
+
+	// CDF is monotonic, so quantile is found by bisection
+	lo, hi := 0.0, 1.0
+	for studentCDF(hi, df) < p {
+		hi *= 2
+	}
+	for i := 0; i < 100; i++ {
+		mid := (lo + hi) / 2
+		if studentCDF(mid, df) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return (lo + hi) / 2
+}
+
+// studentCDF is a cumulative distribution function of Student's t distribution for t >= 0
+func studentCDF(t float64, df int) float64 {
+	v := float64(df)
+	return 1 - 0.5*regularizedBeta(v/(v+t*t), v/2, 0.5)
+}
+
+// regularizedBeta is a regularized incomplete beta function I_x(a, b)
+func regularizedBeta(x, a, b float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	lga, _ := math.Lgamma(a)
+	lgb, _ := math.Lgamma(b)
+	lgab, _ := math.Lgamma(a + b)
+	front := math.Exp(lgab - lga - lgb + a*math.Log(x) + b*math.Log(1-x))
+
+	// Continued fraction converges quickly only for x < (a+1)/(a+b+2)
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(x, a, b) / a
+	}
+
+	return 1 - front*betaContinuedFraction(1-x, b, a)/b
+}
+
+// betaContinuedFraction evaluates continued fraction of incomplete beta function by modified Lentz's method
+func betaContinuedFraction(x, a, b float64) float64 {
+	const (
+		eps  = 1e-14
+		tiny = 1e-300
+	)
+
+	c, d := 1.0, 1-(a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= 300; m++ {
+		fm := float64(m)
+
+		// Even step
+		num := fm * (b - fm) * x / ((a + 2*fm - 1) * (a + 2*fm))
+		d = 1 + num*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + num/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		// Odd step
+		num = -(a + fm) * (a + b + fm) * x / ((a + 2*fm) * (a + 2*fm + 1))
+		d = 1 + num*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + num/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+
+		if math.Abs(delta-1) < eps {
+			break
+		}
+	}
+
+	return h
+}
@@ -0,0 +1,111 @@
+package simulation
+
+import (
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// systemMetric is a service-wide metric collected in every replication
+type systemMetric struct {
+	Name  string
+	value func(*dto.GetSystemStatsOut) float64
+}
+
+// teamMetric is a per-team metric collected in every replication
+type teamMetric struct {
+	Name  string
+	value func(*dto.TeamStats) float64
+}
+
+// Names of collected metrics. Times are in seconds
+const (
+	MetricArrivals         = "arrivals"
+	MetricCompleted        = "completed"
+	MetricRejected         = "rejected"
+	MetricRejectionProb    = "rejection_probability"
+	MetricReneged          = "reneged"
+	MetricBalked           = "balked"
+	MetricInterrupted      = "interrupted"
+	MetricFailed           = "failed"
+	MetricAvailability     = "availability"
+	MetricHandoffs         = "handoffs"
+	MetricReworked         = "reworked"
+	MetricReworkRate       = "rework_rate"
+	MetricMeanTravelTime   = "mean_travel_time"
+	MetricOvertime         = "overtime"
+	MetricOnShiftUtil      = "on_shift_utilization"
+	MetricThroughput       = "throughput"
+	MetricUtilization      = "utilization"
+	MetricMeanWaitTime     = "mean_wait_time"
+	MetricMeanResponseTime = "mean_response_time"
+	MetricMeanQueueLength  = "mean_queue_length"
+	MetricMaxQueueLength   = "max_queue_length"
+	MetricCost             = "cost"
+	MetricRevenue          = "revenue"
+	MetricProfit           = "profit"
+
+	MetricProcessed  = "processed"
+	MetricBusyTime   = "busy_time"
+	MetricFailures   = "failures"
+	MetricDownTime   = "down_time"
+	MetricReworks    = "reworks"
+	MetricTravelTime = "travel_time"
+)
+
+// SystemMetrics are service-wide metrics in report's order
+var SystemMetrics = []systemMetric{
+	{Name: MetricArrivals, value: func(s *dto.GetSystemStatsOut) float64 { return float64(s.Arrivals) }},
+	{Name: MetricCompleted, value: func(s *dto.GetSystemStatsOut) float64 { return float64(s.Completed) }},
+	{Name: MetricRejected, value: func(s *dto.GetSystemStatsOut) float64 { return float64(s.Rejected) }},
+	{Name: MetricRejectionProb, value: func(s *dto.GetSystemStatsOut) float64 {
+		if s.Arrivals == 0 {
+			return 0
+		}
+		return float64(s.Rejected) / float64(s.Arrivals)
+	}},
+	{Name: MetricReneged, value: func(s *dto.GetSystemStatsOut) float64 { return float64(s.Reneged) }},
+	{Name: MetricBalked, value: func(s *dto.GetSystemStatsOut) float64 { return float64(s.Balked) }},
+	{Name: MetricInterrupted, value: func(s *dto.GetSystemStatsOut) float64 { return float64(s.Interrupted) }},
+	{Name: MetricFailed, value: func(s *dto.GetSystemStatsOut) float64 { return float64(s.Failed) }},
+	{Name: MetricThroughput, value: func(s *dto.GetSystemStatsOut) float64 { return s.Throughput }},
+	{Name: MetricUtilization, value: func(s *dto.GetSystemStatsOut) float64 { return s.Utilization }},
+	{Name: MetricAvailability, value: func(s *dto.GetSystemStatsOut) float64 { return s.Availability }},
+	{Name: MetricOnShiftUtil, value: func(s *dto.GetSystemStatsOut) float64 { return s.OnShiftUtilization }},
+	{Name: MetricOvertime, value: func(s *dto.GetSystemStatsOut) float64 { return s.Overtime.Seconds() }},
+	{Name: MetricHandoffs, value: func(s *dto.GetSystemStatsOut) float64 { return float64(s.Handoffs) }},
+	{Name: MetricReworked, value: func(s *dto.GetSystemStatsOut) float64 { return float64(s.Reworked) }},
+	{Name: MetricReworkRate, value: func(s *dto.GetSystemStatsOut) float64 { return s.ReworkRate }},
+	{Name: MetricMeanWaitTime, value: func(s *dto.GetSystemStatsOut) float64 { return s.MeanWaitTime.Seconds() }},
+	{Name: MetricMeanTravelTime, value: func(s *dto.GetSystemStatsOut) float64 { return s.MeanTravelTime.Seconds() }},
+	{Name: MetricMeanResponseTime, value: func(s *dto.GetSystemStatsOut) float64 { return s.MeanResponseTime.Seconds() }},
+	{Name: MetricMeanQueueLength, value: func(s *dto.GetSystemStatsOut) float64 { return s.MeanQueueLength }},
+	{Name: MetricMaxQueueLength, value: func(s *dto.GetSystemStatsOut) float64 { return float64(s.MaxQueueLength) }},
+	{Name: MetricCost, value: func(s *dto.GetSystemStatsOut) float64 { return s.Cost }},
+	{Name: MetricRevenue, value: func(s *dto.GetSystemStatsOut) float64 { return s.Revenue }},
+	{Name: MetricProfit, value: func(s *dto.GetSystemStatsOut) float64 { return s.Profit }},
+}
+
+var teamMetrics = []teamMetric{
+	{Name: MetricProcessed, value: func(t *dto.TeamStats) float64 { return float64(t.ProcessedRequests) }},
+	{Name: MetricBusyTime, value: func(t *dto.TeamStats) float64 { return t.TotalBusyTime.Seconds() }},
+	{Name: MetricUtilization, value: func(t *dto.TeamStats) float64 { return t.Utilization }},
+	{Name: MetricFailures, value: func(t *dto.TeamStats) float64 { return float64(t.Failures) }},
+	{Name: MetricDownTime, value: func(t *dto.TeamStats) float64 { return t.TotalDownTime.Seconds() }},
+	{Name: MetricAvailability, value: func(t *dto.TeamStats) float64 { return t.Availability }},
+	{Name: MetricOnShiftUtil, value: func(t *dto.TeamStats) float64 { return t.OnShiftUtilization }},
+	{Name: MetricOvertime, value: func(t *dto.TeamStats) float64 { return t.Overtime.Seconds() }},
+	{Name: MetricHandoffs, value: func(t *dto.TeamStats) float64 { return float64(t.Handoffs) }},
+	{Name: MetricReworks, value: func(t *dto.TeamStats) float64 { return float64(t.Reworks) }},
+	{Name: MetricReworkRate, value: func(t *dto.TeamStats) float64 { return t.ReworkRate }},
+	{Name: MetricTravelTime, value: func(t *dto.TeamStats) float64 { return t.TravelTime.Seconds() }},
+	{Name: MetricCost, value: func(t *dto.TeamStats) float64 { return t.Cost }},
+	{Name: MetricRevenue, value: func(t *dto.TeamStats) float64 { return t.Revenue }},
+}
+
+// TeamMetricNames are per-team metrics in report's order
+var TeamMetricNames = func() []string {
+	names := make([]string, 0, len(teamMetrics))
+	for _, m := range teamMetrics {
+		names = append(names, m.Name)
+	}
+	return names
+}()
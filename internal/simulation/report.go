@@ -0,0 +1,122 @@
+package simulation
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// Report summarizes replications of a scenario
+type Report struct {
+	Scenario     *Scenario            `json:"scenario"`
+	Replications []*Replication       `json:"replications"`
+	System       map[string]*Estimate `json:"system"`
+	Teams        []*TeamReport        `json:"teams"`
+}
+
+// TeamReport summarizes metrics of a single team across replications
+type TeamReport struct {
+	Id      uint64               `json:"id"`
+	Metrics map[string]*Estimate `json:"metrics"`
+}
+
+func newReport(sc *Scenario, replications []*Replication) *Report {
+	r := &Report{
+		Scenario:     sc,
+		Replications: replications,
+		System:       make(map[string]*Estimate, len(SystemMetrics)),
+	}
+
+	samples := make([]float64, 0, len(replications))
+	for _, m := range SystemMetrics {
+		samples = samples[:0]
+		for _, rep := range replications {
+			samples = append(samples, rep.System[m.Name])
+		}
+		r.System[m.Name] = NewEstimate(samples, sc.Confidence)
+	}
+
+	for i := uint64(0); i < sc.Service.TeamsAmount; i++ {
+		tr := &TeamReport{
+			Id:      i,
+			Metrics: make(map[string]*Estimate, len(TeamMetricNames)),
+		}
+		for _, name := range TeamMetricNames {
+			samples = samples[:0]
+			for _, rep := range replications {
+				samples = append(samples, rep.Teams[i].Metrics[name])
+			}
+			tr.Metrics[name] = NewEstimate(samples, sc.Confidence)
+		}
+		r.Teams = append(r.Teams, tr)
+	}
+
+	return r
+}
+
+// WriteJSON writes the whole report as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
+
+// WriteSummaryCSV writes estimates of system and per-team metrics, one metric per row
+func (r *Report) WriteSummaryCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	_ = cw.Write([]string{"scope", "team", "metric", "mean", "std_dev", "half_width", "low", "high", "n"})
+	for _, m := range SystemMetrics {
+		_ = cw.Write(estimateRow("system", "", m.Name, r.System[m.Name]))
+	}
+	for _, team := range r.Teams {
+		for _, name := range TeamMetricNames {
+			_ = cw.Write(estimateRow("team", strconv.FormatUint(team.Id, 10), name, team.Metrics[name]))
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteReplicationsCSV writes system metrics of every replication, one replication per row
+func (r *Report) WriteReplicationsCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{"replication", "seed"}
+	for _, m := range SystemMetrics {
+		header = append(header, m.Name)
+	}
+	_ = cw.Write(header)
+
+	for _, rep := range r.Replications {
+		row := []string{strconv.Itoa(rep.Index), strconv.FormatUint(rep.Seed, 10)}
+		for _, m := range SystemMetrics {
+			row = append(row, formatFloat(rep.System[m.Name]))
+		}
+		_ = cw.Write(row)
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func estimateRow(scope, team, metric string, e *Estimate) []string {
+	return []string{
+		scope,
+		team,
+		metric,
+		formatFloat(e.Mean),
+		formatFloat(e.StdDev),
+		formatFloat(e.HalfWidth),
+		formatFloat(e.Low),
+		formatFloat(e.High),
+		strconv.Itoa(e.N),
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', 8, 64)
+}
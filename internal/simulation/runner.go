@@ -0,0 +1,173 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Bazhenator/cleaner/internal/clock"
+	"github.com/Bazhenator/cleaner/internal/generator"
+	"github.com/Bazhenator/cleaner/internal/logic"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+	"github.com/Bazhenator/cleaner/internal/workload"
+	"github.com/Bazhenator/tools/src/logger"
+)
+
+// Replication is a result of a single simulation run
+type Replication struct {
+	Index  int                `json:"index"`
+	Seed   uint64             `json:"seed"`
+	System map[string]float64 `json:"system"`
+	Teams  []*TeamMetrics     `json:"teams"`
+
+	Timeline []*dto.Interval `json:"-"` // Timeline is teams' activity, nil unless scenario keeps it
+}
+
+// TeamMetrics are metrics of a single team in a single replication
+type TeamMetrics struct {
+	Id      uint64             `json:"id"`
+	Speed   uint32             `json:"speed"`
+	Metrics map[string]float64 `json:"metrics"`
+}
+
+// Run carries out all scenario's replications in parallel and summarizes them
+func Run(ctx context.Context, sc *Scenario) (*Report, error) {
+	if err := sc.Validate(); err != nil {
+		return nil, err
+	}
+
+	replications := make([]*Replication, sc.Replications)
+	errs := make([]error, sc.Replications)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for i := range replications {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			replications[i], errs[i] = RunReplication(ctx, sc, i)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("replication %d: %w", i, err)
+		}
+	}
+
+	return newReport(sc, replications), nil
+}
+
+// RunReplication carries out a single replication of scenario with seed Seed+index
+func RunReplication(ctx context.Context, sc *Scenario, index int) (*Replication, error) {
+	seed := sc.Seed + uint64(index)
+	l := &logger.Logger{Logger: zap.NewNop()}
+
+	// Every replication owns its copy of configuration, since generator's seed differs
+	c := *sc.Service
+	generatorConfig := *sc.Service.Generator
+	generatorConfig.Seed = seed
+	c.Generator = &generatorConfig
+
+	// Parallel replications write separate event logs
+	if sc.Service.Events != nil && sc.Service.Events.File != "" {
+		eventsConfig := *sc.Service.Events
+		ext := filepath.Ext(eventsConfig.File)
+		eventsConfig.File = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(eventsConfig.File, ext), index, ext)
+		c.Events = &eventsConfig
+	}
+
+	clk := clock.NewVirtual(sc.Start)
+	opts := []logic.Option{logic.WithClock(clk), logic.WithRand(rand.New(rand.NewPCG(seed, ^seed)))}
+	keepTimeline := sc.Timeline && index == 0
+	if keepTimeline {
+		opts = append(opts, logic.WithTimelineCapacity(0))
+	}
+	service, err := logic.NewService(&c, l, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer service.Close()
+
+	source, err := workload.NewSource(c.Generator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	generator.NewGenerator(l, clk, source, service).Start(ctx)
+
+	// Without warm-up nothing is discarded, trace's arrival at the very start included
+	if sc.WarmUp > 0 {
+		clk.RunUntil(sc.Start.Add(time.Duration(sc.WarmUp)))
+		service.ResetStats(ctx)
+	}
+	clk.RunUntil(sc.Start.Add(time.Duration(sc.Horizon)))
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	system, err := service.GetSystemStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := service.GetTeamsStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newReplication(index, seed, system, teams.Stats)
+	if keepTimeline {
+		timeline, err := service.GetTimeline(ctx, &dto.GetTimelineIn{})
+		if err != nil {
+			return nil, err
+		}
+		r.Timeline = timeline.Intervals
+	}
+
+	return r, nil
+}
+
+func newReplication(index int, seed uint64, system *dto.GetSystemStatsOut, teams []*dto.TeamStats) *Replication {
+	r := &Replication{
+		Index:  index,
+		Seed:   seed,
+		System: make(map[string]float64, len(SystemMetrics)),
+		Teams:  make([]*TeamMetrics, 0, len(teams)),
+	}
+
+	for _, m := range SystemMetrics {
+		r.System[m.Name] = m.value(system)
+	}
+
+	for _, team := range teams {
+		tm := &TeamMetrics{
+			Id:      team.Id,
+			Speed:   team.Speed,
+			Metrics: make(map[string]float64, len(TeamMetricNames)),
+		}
+		for _, m := range teamMetrics {
+			tm.Metrics[m.Name] = m.value(team)
+		}
+		r.Teams = append(r.Teams, tm)
+	}
+
+	return r
+}
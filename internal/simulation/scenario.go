@@ -0,0 +1,148 @@
+// Package simulation runs cleaning service on a virtual clock to carry out reproducible experiments
+package simulation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/workload"
+)
+
+// Scenario describes a simulation experiment: cleaner's configuration, workload and replications
+type Scenario struct {
+	Service      *configs.Config `json:"service"`
+	Start        time.Time       `json:"start"`        // Start is a virtual time each replication starts at
+	Horizon      Duration        `json:"horizon"`      // Horizon is a simulated time of each replication, warm-up included
+	WarmUp       Duration        `json:"warm_up"`      // WarmUp is a period discarded from statistics
+	Replications int             `json:"replications"` // Replications is amount of independent runs
+	Seed         uint64          `json:"seed"`         // Seed of replication i is Seed+i
+	Confidence   float64         `json:"confidence"`   // Confidence is a level of confidence intervals, e.g. 0.95
+
+	Timeline bool `json:"timeline,omitempty"` // Timeline keeps teams' whole activity of the first replication, e.g. for Gantt chart
+}
+
+// Default values of scenario
+const (
+	DefReplications = 10
+	DefConfidence   = 0.95
+	DefHorizon      = Duration(24 * time.Hour)
+)
+
+// DefStart is a default virtual time of replications' start
+var DefStart = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// LoadScenario reads scenario from JSON file
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario: %w", err)
+	}
+
+	sc := &Scenario{}
+	if err := json.Unmarshal(data, sc); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+
+	return sc, nil
+}
+
+// Validate checks scenario and fills omitted fields with default values
+func (sc *Scenario) Validate() error {
+	if sc.Service == nil {
+		return errors.New("scenario has no service configuration")
+	}
+	if sc.Service.TeamsAmount == 0 {
+		return errors.New("scenario has no teams")
+	}
+	if sc.Service.BaseSpeed == 0 {
+		return errors.New("scenario has no base speed")
+	}
+	if sc.Service.Generator == nil {
+		return errors.New("scenario has no generator")
+	}
+	if slices.Contains(sc.Service.TeamsCapacities, 0) {
+		return errors.New("scenario has team without capacity")
+	}
+	if sc.Service.AssignPolicy == "" {
+		sc.Service.AssignPolicy = configs.DefAssignPolicy
+	}
+	if sc.Service.Generator.Priorities == "" {
+		sc.Service.Generator.Priorities = configs.DefGeneratorPriorities
+	}
+	if sc.Service.Generator.Types == "" {
+		sc.Service.Generator.Types = configs.DefGeneratorTypes
+	}
+	if sc.Service.Generator.Clients == 0 {
+		sc.Service.Generator.Clients = configs.DefGeneratorClients
+	}
+	if b := sc.Service.Breakdowns; b != nil {
+		if b.FailureDistribution == "" {
+			b.FailureDistribution = configs.DistributionExponential
+		}
+		if b.RepairDistribution == "" {
+			b.RepairDistribution = configs.DistributionExponential
+		}
+		if b.Policy == "" {
+			b.Policy = configs.BreakdownRequeue
+		}
+	}
+
+	// Trace is replayed from its real start up to its last arrival by default
+	if gen := sc.Service.Generator; gen.Process == configs.ProcessTrace && (sc.Horizon == 0 || sc.Start.IsZero()) {
+		trace, err := workload.LoadTrace(gen.TraceFile)
+		if err != nil {
+			return err
+		}
+		if sc.Horizon == 0 {
+			sc.Horizon = Duration(trace.Span())
+		}
+		if sc.Start.IsZero() {
+			sc.Start = trace.Origin()
+		}
+	}
+	if sc.Horizon == 0 {
+		sc.Horizon = DefHorizon
+	}
+
+	if sc.Horizon <= sc.WarmUp {
+		return errors.New("horizon must be longer than warm-up")
+	}
+	if sc.Start.IsZero() {
+		sc.Start = DefStart
+	}
+	if sc.Replications <= 0 {
+		sc.Replications = DefReplications
+	}
+	if sc.Confidence <= 0 || sc.Confidence >= 1 {
+		sc.Confidence = DefConfidence
+	}
+
+	return nil
+}
+
+// Duration is a time.Duration, which is written in JSON as a string like "1h30m"
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"1h30m\": %w", err)
+	}
+
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(parsed)
+	return nil
+}
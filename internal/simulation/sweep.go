@@ -0,0 +1,208 @@
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// parameter is a scenario's parameter, which can be swept
+type parameter struct {
+	Name  string
+	apply func(sc *Scenario, value float64) error
+}
+
+// Names of sweepable parameters
+const (
+	ParamTeams     = "teams"
+	ParamBaseSpeed = "base_speed"
+	ParamRate      = "rate"
+	ParamCapacity  = "queue_capacity"
+)
+
+var parameters = []parameter{
+	{Name: ParamTeams, apply: func(sc *Scenario, v float64) error {
+		if v < 1 || v != math.Trunc(v) {
+			return fmt.Errorf("teams must be a positive integer, got %g", v)
+		}
+		sc.Service.TeamsAmount = uint64(v)
+		return nil
+	}},
+	{Name: ParamBaseSpeed, apply: func(sc *Scenario, v float64) error {
+		if v < 1 || v != math.Trunc(v) {
+			return fmt.Errorf("base speed must be a positive integer, got %g", v)
+		}
+		sc.Service.BaseSpeed = uint64(v)
+		return nil
+	}},
+	{Name: ParamRate, apply: func(sc *Scenario, v float64) error {
+		if v <= 0 {
+			return fmt.Errorf("rate must be positive, got %g", v)
+		}
+		sc.Service.Generator.Rate = v
+		return nil
+	}},
+	{Name: ParamCapacity, apply: func(sc *Scenario, v float64) error {
+		if v < 0 || v != math.Trunc(v) {
+			return fmt.Errorf("queue capacity must be a non-negative integer, got %g", v)
+		}
+		capacity := uint64(v)
+		sc.Service.QueueCapacity = &capacity
+		return nil
+	}},
+}
+
+// SweepMetrics are system metrics reported for every point of sweep
+var SweepMetrics = []string{MetricUtilization, MetricMeanWaitTime, MetricMeanResponseTime, MetricRejectionProb}
+
+// Axis is a swept parameter with its values
+type Axis struct {
+	Param  string    `json:"param"`
+	Values []float64 `json:"values"`
+}
+
+// ParseAxis parses axis definition: "teams=1:20" (step 1), "rate=0.01:0.1:0.01" or "base_speed=30,60,120"
+func ParseAxis(def string) (*Axis, error) {
+	name, valuesDef, ok := strings.Cut(def, "=")
+	if !ok {
+		return nil, fmt.Errorf("axis %q must look like param=values", def)
+	}
+
+	name = strings.TrimSpace(name)
+	if !slices.ContainsFunc(parameters, func(p parameter) bool { return p.Name == name }) {
+		return nil, fmt.Errorf("unknown parameter %q", name)
+	}
+
+	axis := &Axis{Param: name}
+
+	if strings.Contains(valuesDef, ":") {
+		bounds := strings.Split(valuesDef, ":")
+		if len(bounds) > 3 {
+			return nil, fmt.Errorf("range %q must look like from:to[:step]", valuesDef)
+		}
+
+		nums := make([]float64, 0, 3)
+		for _, b := range bounds {
+			f, err := strconv.ParseFloat(strings.TrimSpace(b), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid range %q: %w", valuesDef, err)
+			}
+			nums = append(nums, f)
+		}
+
+		from, to, step := nums[0], nums[1], 1.0
+		if len(nums) == 3 {
+			step = nums[2]
+		}
+		if step <= 0 || to < from {
+			return nil, fmt.Errorf("invalid range %q", valuesDef)
+		}
+
+		// Values are computed from index to avoid accumulating float error
+		for i := 0; ; i++ {
+			v := from + float64(i)*step
+			if v > to+step*1e-9 {
+				break
+			}
+			axis.Values = append(axis.Values, v)
+		}
+	} else {
+		for _, v := range strings.Split(valuesDef, ",") {
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q: %w", v, err)
+			}
+			axis.Values = append(axis.Values, f)
+		}
+	}
+
+	if len(axis.Values) == 0 {
+		return nil, fmt.Errorf("axis %q has no values", def)
+	}
+
+	return axis, nil
+}
+
+// SweepPoint is a result of scenario's replications with swept parameters set to Values
+type SweepPoint struct {
+	Values  []float64            `json:"values"` // Values are in axes' order
+	Metrics map[string]*Estimate `json:"metrics"`
+}
+
+// SweepReport is a grid of sweep points
+type SweepReport struct {
+	Axes   []*Axis       `json:"axes"`
+	Points []*SweepPoint `json:"points"`
+}
+
+// Sweep runs base scenario in every point of one or two axes' grid.
+// progress is called after every point and may be nil
+func Sweep(ctx context.Context, base *Scenario, axes []*Axis, progress func(done, total int)) (*SweepReport, error) {
+	if len(axes) == 0 || len(axes) > 2 {
+		return nil, errors.New("sweep needs one or two axes")
+	}
+	if err := base.Validate(); err != nil {
+		return nil, err
+	}
+
+	grid := [][]float64{{}}
+	for _, axis := range axes {
+		next := make([][]float64, 0, len(grid)*len(axis.Values))
+		for _, point := range grid {
+			for _, v := range axis.Values {
+				next = append(next, append(slices.Clone(point), v))
+			}
+		}
+		grid = next
+	}
+
+	report := &SweepReport{Axes: axes}
+	for i, values := range grid {
+		sc, err := base.withParams(axes, values)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := Run(ctx, sc)
+		if err != nil {
+			return nil, fmt.Errorf("point %v: %w", values, err)
+		}
+
+		point := &SweepPoint{
+			Values:  values,
+			Metrics: make(map[string]*Estimate, len(SweepMetrics)),
+		}
+		for _, name := range SweepMetrics {
+			point.Metrics[name] = r.System[name]
+		}
+		report.Points = append(report.Points, point)
+
+		if progress != nil {
+			progress(i+1, len(grid))
+		}
+	}
+
+	return report, nil
+}
+
+// withParams returns copy of scenario with swept parameters set
+func (sc *Scenario) withParams(axes []*Axis, values []float64) (*Scenario, error) {
+	c := *sc
+	service := *sc.Service
+	generator := *sc.Service.Generator
+	service.Generator = &generator
+	c.Service = &service
+
+	for i, axis := range axes {
+		idx := slices.IndexFunc(parameters, func(p parameter) bool { return p.Name == axis.Param })
+		if err := parameters[idx].apply(&c, values[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,133 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// WriteTable writes sweep as a human-readable table with means and confidence half-widths
+func (r *SweepReport) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	header := make([]string, 0, len(r.Axes)+len(SweepMetrics))
+	for _, axis := range r.Axes {
+		header = append(header, strings.ToUpper(axis.Param))
+	}
+	for _, name := range SweepMetrics {
+		header = append(header, strings.ToUpper(name))
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	for _, p := range r.Points {
+		row := make([]string, 0, len(header))
+		for _, v := range p.Values {
+			row = append(row, formatFloat(v))
+		}
+		for _, name := range SweepMetrics {
+			e := p.Metrics[name]
+			row = append(row, fmt.Sprintf("%.4f ± %.4f", e.Mean, e.HalfWidth))
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// WriteGnuplot writes sweep as gnuplot data file. Columns are axes' values followed by mean, low and high of every metric.
+// Two-dimensional grids are split into blocks by the first axis, so they can be drawn with splot
+func (r *SweepReport) WriteGnuplot(w io.Writer) error {
+	columns := make([]string, 0, len(r.Axes)+3*len(SweepMetrics))
+	for _, axis := range r.Axes {
+		columns = append(columns, axis.Param)
+	}
+	for _, name := range SweepMetrics {
+		columns = append(columns, name, name+"_low", name+"_high")
+	}
+	if _, err := fmt.Fprintf(w, "# %s\n", strings.Join(columns, " ")); err != nil {
+		return err
+	}
+
+	for i, p := range r.Points {
+		if len(r.Axes) == 2 && i > 0 && p.Values[0] != r.Points[i-1].Values[0] {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+
+		row := make([]string, 0, len(columns))
+		for _, v := range p.Values {
+			row = append(row, formatFloat(v))
+		}
+		for _, name := range SweepMetrics {
+			e := p.Metrics[name]
+			row = append(row, formatFloat(e.Mean), formatFloat(e.Low), formatFloat(e.High))
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(row, " ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteVegaLite writes Vega-Lite specification with inlined data: one chart per metric with
+// confidence bands, the second axis (if any) is shown by color
+func (r *SweepReport) WriteVegaLite(w io.Writer) error {
+	values := make([]map[string]any, 0, len(r.Points)*len(SweepMetrics))
+	for _, p := range r.Points {
+		for _, name := range SweepMetrics {
+			e := p.Metrics[name]
+			row := map[string]any{
+				"metric": name,
+				"mean":   e.Mean,
+				"low":    e.Low,
+				"high":   e.High,
+			}
+			for i, axis := range r.Axes {
+				row[axis.Param] = p.Values[i]
+			}
+			values = append(values, row)
+		}
+	}
+
+	x := map[string]any{"field": r.Axes[0].Param, "type": "quantitative"}
+	encoding := map[string]any{"x": x}
+	if len(r.Axes) == 2 {
+		encoding["color"] = map[string]any{"field": r.Axes[1].Param, "type": "ordinal"}
+	}
+
+	spec := map[string]any{
+		"$schema": "https://vega.github.io/schema/vega-lite/v5.json",
+		"data":    map[string]any{"values": values},
+		"facet":   map[string]any{"row": map[string]any{"field": "metric", "type": "nominal"}},
+		"resolve": map[string]any{"scale": map[string]any{"y": "independent"}},
+		"spec": map[string]any{
+			"width":    480,
+			"height":   200,
+			"encoding": encoding,
+			"layer": []map[string]any{
+				{
+					"mark": map[string]any{"type": "errorband"},
+					"encoding": map[string]any{
+						"y":  map[string]any{"field": "low", "type": "quantitative", "title": "value"},
+						"y2": map[string]any{"field": "high"},
+					},
+				},
+				{
+					"mark": map[string]any{"type": "line", "point": true},
+					"encoding": map[string]any{
+						"y": map[string]any{"field": "mean", "type": "quantitative", "title": "value"},
+					},
+				},
+			},
+		},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(spec)
+}
@@ -0,0 +1,137 @@
+// Package travel estimates time teams spend on the way between clients' locations
+package travel
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+var ErrUnknownSite = errors.New("site is not in distance matrix")
+
+// Model estimates travel time between locations
+type Model interface {
+	// Time returns travel time from one location to another
+	Time(from, to dto.Location) time.Duration
+	// Validate checks whether location is known to model
+	Validate(loc dto.Location) error
+}
+
+// NewModel creates travel-time model described by configuration
+func NewModel(c *configs.TravelConfig) (Model, error) {
+	speed := c.Speed
+	if speed == 0 {
+		speed = 1
+	}
+	if speed < 0 {
+		return nil, errors.New("travel speed must be positive")
+	}
+
+	switch c.Model {
+	case configs.TravelEuclidean:
+		return &Euclidean{Speed: speed}, nil
+	case configs.TravelManhattan:
+		return &Manhattan{Speed: speed}, nil
+	case configs.TravelMatrix:
+		distances, err := LoadMatrix(c.MatrixFile)
+		if err != nil {
+			return nil, err
+		}
+		return &Matrix{Distances: distances, Speed: speed}, nil
+	default:
+		return nil, fmt.Errorf("unknown travel model %q", c.Model)
+	}
+}
+
+// Euclidean model travels straight between points with constant speed
+type Euclidean struct {
+	Speed float64
+}
+
+func (m *Euclidean) Time(from, to dto.Location) time.Duration {
+	return seconds(math.Hypot(to.X-from.X, to.Y-from.Y) / m.Speed)
+}
+
+func (m *Euclidean) Validate(dto.Location) error {
+	return nil
+}
+
+// Manhattan model travels along grid of streets with constant speed
+type Manhattan struct {
+	Speed float64
+}
+
+func (m *Manhattan) Time(from, to dto.Location) time.Duration {
+	return seconds((math.Abs(to.X-from.X) + math.Abs(to.Y-from.Y)) / m.Speed)
+}
+
+func (m *Manhattan) Validate(dto.Location) error {
+	return nil
+}
+
+// Matrix model looks up distances between sites with constant speed
+type Matrix struct {
+	Distances [][]float64 // Distances are distances from site to site by their indices
+	Speed     float64
+}
+
+func (m *Matrix) Time(from, to dto.Location) time.Duration {
+	if from.Site >= uint64(len(m.Distances)) || to.Site >= uint64(len(m.Distances)) {
+		return 0
+	}
+
+	return seconds(m.Distances[from.Site][to.Site] / m.Speed)
+}
+
+func (m *Matrix) Validate(loc dto.Location) error {
+	if loc.Site >= uint64(len(m.Distances)) {
+		return fmt.Errorf("%w: site %d, matrix has %d sites", ErrUnknownSite, loc.Site, len(m.Distances))
+	}
+
+	return nil
+}
+
+// LoadMatrix reads square matrix of distances between sites from CSV file
+func LoadMatrix(path string) ([][]float64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid distance matrix %s: %w", path, err)
+	}
+
+	distances := make([][]float64, 0, len(records))
+	for i, record := range records {
+		if len(record) != len(records) {
+			return nil, fmt.Errorf("distance matrix %s is not square: row %d has %d columns", path, i+1, len(record))
+		}
+
+		row := make([]float64, 0, len(record))
+		for j, field := range record {
+			distance, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+			if err != nil || distance < 0 {
+				return nil, fmt.Errorf("invalid distance at row %d column %d of %s", i+1, j+1, path)
+			}
+			row = append(row, distance)
+		}
+		distances = append(distances, row)
+	}
+
+	return distances, nil
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,93 @@
+package workload
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// Exponential samples exponentially distributed duration with given rate (events per second).
+// Interarrival times of Poisson process are exponential
+func Exponential(rng *rand.Rand, rate float64) time.Duration {
+	return time.Duration(rng.ExpFloat64() / rate * float64(time.Second))
+}
+
+// Process is an arrival process, which yields times between consecutive arrivals
+type Process interface {
+	Interarrival(rng *rand.Rand) time.Duration
+}
+
+// Poisson is a Poisson arrival process with constant rate
+type Poisson struct {
+	Rate float64
+}
+
+func (p *Poisson) Interarrival(rng *rand.Rand) time.Duration {
+	return Exponential(rng, p.Rate)
+}
+
+// Deterministic is an arrival process with constant interarrival time
+type Deterministic struct {
+	Rate float64
+}
+
+func (p *Deterministic) Interarrival(_ *rand.Rand) time.Duration {
+	return time.Duration(float64(time.Second) / p.Rate)
+}
+
+// MMPP is a Markov-modulated Poisson process. It cycles through states, each of them has its own arrival rate
+// and exponentially distributed sojourn time, so arrivals come in bursts
+type MMPP struct {
+	rates       []float64
+	switchRates []float64
+
+	state     int
+	remaining time.Duration // remaining is a sojourn time left in current state
+}
+
+// NewMMPP creates MMPP. rates[i] is arrival rate in state i, switchRates[i] is a rate of leaving state i.
+// A state may be silent, but at least one of them must have positive rate, otherwise nothing ever arrives
+func NewMMPP(rates, switchRates []float64) (*MMPP, error) {
+	if len(rates) == 0 || len(rates) != len(switchRates) {
+		return nil, errors.New("mmpp needs the same positive amount of rates and switch rates")
+	}
+	active := false
+	for i := range rates {
+		if rates[i] < 0 || switchRates[i] <= 0 {
+			return nil, fmt.Errorf("invalid rates of mmpp state %d", i)
+		}
+		active = active || rates[i] > 0
+	}
+	if !active {
+		return nil, errors.New("mmpp needs at least one state with positive rate")
+	}
+
+	return &MMPP{
+		rates:       rates,
+		switchRates: switchRates,
+		remaining:   -1,
+	}, nil
+}
+
+func (p *MMPP) Interarrival(rng *rand.Rand) time.Duration {
+	if p.remaining < 0 {
+		p.remaining = Exponential(rng, p.switchRates[p.state])
+	}
+
+	var waited time.Duration
+	for {
+		// Exponential distribution is memoryless, so arrival can be sampled anew after every switch
+		if p.rates[p.state] > 0 {
+			next := Exponential(rng, p.rates[p.state])
+			if next <= p.remaining {
+				p.remaining -= next
+				return waited + next
+			}
+		}
+
+		waited += p.remaining
+		p.state = (p.state + 1) % len(p.rates)
+		p.remaining = Exponential(rng, p.switchRates[p.state])
+	}
+}
@@ -0,0 +1,57 @@
+package workload
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+)
+
+func TestNewMMPP(t *testing.T) {
+	tests := []struct {
+		name        string
+		rates       []float64
+		switchRates []float64
+		ok          bool
+	}{
+		{"bursts", []float64{10, 0.5}, []float64{0.1, 0.2}, true},
+		{"silent state", []float64{0, 2}, []float64{1, 1}, true},
+		{"all rates are zero", []float64{0, 0}, []float64{1, 1}, false},
+		{"negative rate", []float64{-1, 2}, []float64{1, 1}, false},
+		{"zero switch rate", []float64{1, 2}, []float64{0, 1}, false},
+		{"no states", nil, nil, false},
+		{"mismatched lengths", []float64{1, 2}, []float64{1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMMPP(tt.rates, tt.switchRates)
+			if (err == nil) != tt.ok {
+				t.Fatalf("NewMMPP(%v, %v) error = %v, want ok = %v", tt.rates, tt.switchRates, err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestMMPPInterarrivalWithSilentState(t *testing.T) {
+	p, err := NewMMPP([]float64{0, 5}, []float64{1, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	var total time.Duration
+	const arrivals = 10000
+	for range arrivals {
+		d := p.Interarrival(rng)
+		if d < 0 {
+			t.Fatalf("negative interarrival %s", d)
+		}
+		total += d
+	}
+
+	// Half of the time process is silent, so mean rate is 2.5 per second
+	mean := total.Seconds() / arrivals
+	if mean < 0.3 || mean > 0.5 {
+		t.Fatalf("mean interarrival %.3fs, want about 0.4s", mean)
+	}
+}
@@ -0,0 +1,48 @@
+package workload
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+)
+
+// NewSource creates arrivals source described by generator's configuration
+func NewSource(c *configs.GeneratorConfig) (Source, error) {
+	if c.Process == configs.ProcessTrace {
+		return LoadTrace(c.TraceFile)
+	}
+
+	var process Process
+	switch c.Process {
+	case configs.ProcessPoisson:
+		process = &Poisson{Rate: c.Rate}
+	case configs.ProcessDeterministic:
+		process = &Deterministic{Rate: c.Rate}
+	case configs.ProcessMmpp:
+		mmpp, err := NewMMPP(c.MmppRates, c.MmppSwitchRates)
+		if err != nil {
+			return nil, err
+		}
+		process = mmpp
+	default:
+		return nil, fmt.Errorf("unknown arrival process %q", c.Process)
+	}
+
+	priorities, err := ParseMix(c.Priorities)
+	if err != nil {
+		return nil, fmt.Errorf("invalid priorities: %w", err)
+	}
+
+	types, err := ParseMix(c.Types)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cleaning types: %w", err)
+	}
+
+	patience := time.Duration(c.MeanPatience * float64(time.Second))
+
+	source := NewMixedSource(rand.New(rand.NewPCG(c.Seed, c.Seed>>1)), process, priorities, types, c.Clients, patience)
+
+	return source.WithLocations(c.Area, c.Sites), nil
+}
@@ -0,0 +1,36 @@
+package workload
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/Bazhenator/cleaner/configs"
+)
+
+// Distribution samples random durations
+type Distribution func(rng *rand.Rand) time.Duration
+
+// NewDistribution returns distribution with given name and mean
+func NewDistribution(name string, mean time.Duration) (Distribution, error) {
+	if mean <= 0 {
+		return nil, fmt.Errorf("mean of %s distribution must be positive", name)
+	}
+
+	switch name {
+	case configs.DistributionExponential:
+		return func(rng *rand.Rand) time.Duration {
+			return time.Duration(rng.ExpFloat64() * float64(mean))
+		}, nil
+	case configs.DistributionDeterministic:
+		return func(*rand.Rand) time.Duration {
+			return mean
+		}, nil
+	case configs.DistributionUniform:
+		return func(rng *rand.Rand) time.Duration {
+			return time.Duration(rng.Float64() * 2 * float64(mean))
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown distribution %q", name)
+	}
+}
@@ -0,0 +1,94 @@
+// Package workload describes streams of cleaning requests: their arrival times, priorities and types
+package workload
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// Mix is a discrete distribution of values (priorities, cleaning types), which are chosen with given weights
+type Mix struct {
+	values     []uint
+	cumulative []float64
+}
+
+// ParseMix parses mix definition like "1:0.2,2:0.5,3:0.3". Weight may be omitted, "1,2,3" means equal weights
+func ParseMix(def string) (*Mix, error) {
+	m := &Mix{}
+
+	var total float64
+	for _, part := range strings.Split(def, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		valueStr, weightStr, hasWeight := strings.Cut(part, ":")
+
+		value, err := strconv.ParseUint(strings.TrimSpace(valueStr), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mix value %q: %w", valueStr, err)
+		}
+
+		weight := 1.0
+		if hasWeight {
+			weight, err = strconv.ParseFloat(strings.TrimSpace(weightStr), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid mix weight %q: %w", weightStr, err)
+			}
+		}
+		if weight < 0 {
+			return nil, fmt.Errorf("negative mix weight for value %d", value)
+		}
+
+		total += weight
+		m.values = append(m.values, uint(value))
+		m.cumulative = append(m.cumulative, total)
+	}
+
+	if len(m.values) == 0 || total == 0 {
+		return nil, errors.New("empty mix")
+	}
+
+	for i := range m.cumulative {
+		m.cumulative[i] /= total
+	}
+
+	return m, nil
+}
+
+// Fixed returns mix, which always yields the same value
+func Fixed(value uint) *Mix {
+	return &Mix{
+		values:     []uint{value},
+		cumulative: []float64{1},
+	}
+}
+
+// Sample chooses next value from mix
+func (m *Mix) Sample(rng *rand.Rand) uint {
+	u := rng.Float64()
+	for i, c := range m.cumulative {
+		if u < c {
+			return m.values[i]
+		}
+	}
+
+	return m.values[len(m.values)-1]
+}
+
+// String formats mix back to its definition
+func (m *Mix) String() string {
+	parts := make([]string, 0, len(m.values))
+
+	prev := 0.0
+	for i, v := range m.values {
+		parts = append(parts, fmt.Sprintf("%d:%g", v, m.cumulative[i]-prev))
+		prev = m.cumulative[i]
+	}
+
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,79 @@
+package workload
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// Arrival is a single cleaning request coming to cleaner
+type Arrival struct {
+	Delay        time.Duration // Delay is a time since previous arrival
+	ClientId     uint64
+	Priority     uint
+	CleaningType uint
+	Patience     time.Duration // Patience is a max time request waits in queue, zero means unlimited
+	Deadline     time.Duration // Deadline is a time since arrival cleaning must start within, zero means no deadline
+	Location     *dto.Location // Location is a client's site, nil if arrivals have no locations
+}
+
+// Source yields arrivals one after another
+type Source interface {
+	// Next returns next arrival. Returns false when source is exhausted
+	Next() (*Arrival, bool)
+}
+
+// MixedSource combines arrival process with priority and cleaning type mixes
+type MixedSource struct {
+	rng *rand.Rand
+
+	process    Process
+	priorities *Mix
+	types      *Mix
+	clients    uint64
+	patience   time.Duration // patience is a mean of exponential patience, arrivals are patient if zero
+	area       float64       // area is a side of square locations are uniformly drawn from
+	sites      uint64        // sites is an amount of distance matrix's sites locations are drawn from
+}
+
+func NewMixedSource(rng *rand.Rand, process Process, priorities, types *Mix, clients uint64, patience time.Duration) *MixedSource {
+	return &MixedSource{
+		rng: rng,
+
+		process:    process,
+		priorities: priorities,
+		types:      types,
+		clients:    max(clients, 1),
+		patience:   patience,
+	}
+}
+
+// WithLocations makes source draw arrivals' locations uniformly from square area and distance matrix's sites.
+// Arrivals have no locations if both are zero
+func (s *MixedSource) WithLocations(area float64, sites uint64) *MixedSource {
+	s.area = area
+	s.sites = sites
+
+	return s
+}
+
+func (s *MixedSource) Next() (*Arrival, bool) {
+	arrival := &Arrival{
+		Delay:        s.process.Interarrival(s.rng),
+		ClientId:     s.rng.Uint64N(s.clients),
+		Priority:     s.priorities.Sample(s.rng),
+		CleaningType: s.types.Sample(s.rng),
+	}
+	if s.patience > 0 {
+		arrival.Patience = time.Duration(s.rng.ExpFloat64() * float64(s.patience))
+	}
+	if s.area > 0 || s.sites > 0 {
+		arrival.Location = &dto.Location{X: s.rng.Float64() * s.area, Y: s.rng.Float64() * s.area}
+		if s.sites > 0 {
+			arrival.Location.Site = s.rng.Uint64N(s.sites)
+		}
+	}
+
+	return arrival, true
+}
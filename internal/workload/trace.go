@@ -0,0 +1,244 @@
+package workload
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/eventlog"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+// traceRecord is a single arrival read from trace
+type traceRecord struct {
+	at           time.Duration // at is an arrival time since trace's beginning
+	clientId     uint64
+	cleaningType uint
+	priority     uint
+	patience     time.Duration
+	deadline     time.Duration // deadline is relative to arrival, so trace replayed at another time keeps it
+	location     *dto.Location
+}
+
+// TraceSource replays arrivals recorded in a trace
+type TraceSource struct {
+	records []traceRecord
+	origin  time.Time // origin is a moment of the first arrival, zero if trace has relative timestamps only
+	next    int
+}
+
+// LoadTrace reads trace from CSV file with "timestamp,client_id,cleaning_type,priority" columns.
+// Timestamp is either RFC 3339 time or seconds since trace's beginning. Header row is optional.
+// File of JSON records is read as service's event log, see LoadEventLog
+func LoadTrace(path string) (*TraceSource, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trace: %w", err)
+	}
+	defer f.Close()
+
+	br := bufio.NewReader(f)
+	if isJSON(br) {
+		return LoadEventLog(path)
+	}
+
+	return ReadTrace(br)
+}
+
+// LoadEventLog reads arrivals of requests submitted to service from its event log, rotated files included.
+// Scheduled requests and subscriptions' occurrences are not arrivals and are skipped
+func LoadEventLog(path string) (*TraceSource, error) {
+	var records []traceRecord
+	var origin time.Time
+	err := eventlog.ReadFiles(path, func(rec *eventlog.Record) error {
+		req := rec.Request
+		if rec.Type != eventlog.TypeName(dto.EventRequestSubmitted) || req == nil ||
+			req.ScheduledAt != nil || req.SubscriptionId != 0 {
+			return nil
+		}
+		if origin.IsZero() {
+			origin = rec.Time
+		}
+
+		tr := traceRecord{
+			at:           rec.Time.Sub(origin),
+			clientId:     req.ClientId,
+			cleaningType: req.CleaningType,
+			priority:     req.Priority,
+			patience:     time.Duration(req.Patience * float64(time.Second)),
+		}
+		if req.Deadline != nil {
+			tr.deadline = req.Deadline.Sub(rec.Time)
+		}
+		if loc := req.Location; loc != nil {
+			tr.location = &dto.Location{X: loc.X, Y: loc.Y, Site: loc.Site}
+		}
+		records = append(records, tr)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event log: %w", err)
+	}
+
+	return newTraceSource(records, origin)
+}
+
+// ReadTrace reads CSV trace, see LoadTrace
+func ReadTrace(r io.Reader) (*TraceSource, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 4
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+
+	var (
+		records []traceRecord
+		origin  time.Time
+	)
+	for line := 1; ; line++ {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read trace: %w", err)
+		}
+		if line == 1 && strings.EqualFold(row[0], "timestamp") {
+			continue
+		}
+
+		rec, ts, err := parseTraceRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("trace line %d: %w", line, err)
+		}
+		if !ts.IsZero() {
+			if origin.IsZero() {
+				origin = ts
+			}
+			rec.at = ts.Sub(origin)
+		}
+
+		records = append(records, rec)
+	}
+
+	return newTraceSource(records, origin)
+}
+
+// newTraceSource orders records by arrival times, which are relative to origin
+func newTraceSource(records []traceRecord, origin time.Time) (*TraceSource, error) {
+	if len(records) == 0 {
+		return nil, errors.New("empty trace")
+	}
+
+	slices.SortStableFunc(records, func(a, b traceRecord) int {
+		return cmp.Compare(a.at, b.at)
+	})
+
+	// Absolute timestamps are relative to the earliest one
+	if first := records[0].at; first < 0 {
+		for i := range records {
+			records[i].at -= first
+		}
+		if !origin.IsZero() {
+			origin = origin.Add(first)
+		}
+	}
+
+	return &TraceSource{records: records, origin: origin}, nil
+}
+
+// isJSON reports whether buffered content starts with JSON object
+func isJSON(br *bufio.Reader) bool {
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return false
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = br.ReadByte()
+		default:
+			return b[0] == '{'
+		}
+	}
+}
+
+// parseTraceRow parses CSV row. Returns absolute timestamp if row has one
+func parseTraceRow(row []string) (traceRecord, time.Time, error) {
+	var (
+		rec traceRecord
+		ts  time.Time
+	)
+
+	if seconds, err := strconv.ParseFloat(row[0], 64); err == nil {
+		rec.at = time.Duration(seconds * float64(time.Second))
+	} else if ts, err = time.Parse(time.RFC3339Nano, row[0]); err != nil {
+		return rec, ts, fmt.Errorf("invalid timestamp %q", row[0])
+	}
+
+	clientId, err := strconv.ParseUint(row[1], 10, 64)
+	if err != nil {
+		return rec, ts, fmt.Errorf("invalid client id %q", row[1])
+	}
+	cleaningType, err := strconv.ParseUint(row[2], 10, 32)
+	if err != nil {
+		return rec, ts, fmt.Errorf("invalid cleaning type %q", row[2])
+	}
+	priority, err := strconv.ParseUint(row[3], 10, 32)
+	if err != nil {
+		return rec, ts, fmt.Errorf("invalid priority %q", row[3])
+	}
+
+	rec.clientId = clientId
+	rec.cleaningType = uint(cleaningType)
+	rec.priority = uint(priority)
+
+	return rec, ts, nil
+}
+
+func (s *TraceSource) Next() (*Arrival, bool) {
+	if s.next >= len(s.records) {
+		return nil, false
+	}
+
+	rec := s.records[s.next]
+
+	delay := rec.at
+	if s.next > 0 {
+		delay -= s.records[s.next-1].at
+	}
+	s.next++
+
+	return &Arrival{
+		Delay:        delay,
+		ClientId:     rec.clientId,
+		Priority:     rec.priority,
+		CleaningType: rec.cleaningType,
+		Patience:     rec.patience,
+		Deadline:     rec.deadline,
+		Location:     rec.location,
+	}, true
+}
+
+// Len returns amount of arrivals in trace
+func (s *TraceSource) Len() int {
+	return len(s.records)
+}
+
+// Origin returns a moment of the first arrival. Returns zero time if trace has relative timestamps only
+func (s *TraceSource) Origin() time.Time {
+	return s.origin
+}
+
+// Span returns a time between the first and the last arrivals
+func (s *TraceSource) Span() time.Duration {
+	return s.records[len(s.records)-1].at
+}
@@ -0,0 +1,68 @@
+package workload
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Bazhenator/cleaner/internal/eventlog"
+	"github.com/Bazhenator/cleaner/internal/logic/dto"
+)
+
+func TestLoadEventLog(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	events := []*dto.Event{
+		{Type: dto.EventRequestSubmitted, Time: start, Request: &dto.Request{
+			Id: 1, ClientId: 7, CleaningType: 1, Priority: 2, Patience: 10 * time.Minute, Deadline: start.Add(time.Hour),
+		}},
+		{Type: dto.EventRequestAssigned, Time: start, Request: &dto.Request{Id: 1, Status: dto.RequestInProgress}},
+		{Type: dto.EventRequestSubmitted, Time: start.Add(20 * time.Second), Request: &dto.Request{Id: 2, ScheduledAt: start.Add(time.Hour)}},
+		{Type: dto.EventRequestSubmitted, Time: start.Add(30 * time.Second), Request: &dto.Request{
+			Id: 3, ClientId: 8, Location: &dto.Location{X: 3, Y: 4, Site: 1},
+		}},
+	}
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	w, err := eventlog.NewWriter(path, 1<<20, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range events {
+		e.Seq = uint64(i + 1)
+		if err := w.Write(eventlog.NewRecord(e)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	trace, err := LoadTrace(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trace.Len() != 2 || !trace.Origin().Equal(start) || trace.Span() != 30*time.Second {
+		t.Fatalf("len = %d, origin = %s, span = %s, want 2 arrivals since %s in 30s", trace.Len(), trace.Origin(), trace.Span(), start)
+	}
+
+	// Scheduled request is not an arrival, deadline is kept relative to arrival
+	want := []Arrival{
+		{ClientId: 7, CleaningType: 1, Priority: 2, Patience: 10 * time.Minute, Deadline: time.Hour},
+		{Delay: 30 * time.Second, ClientId: 8, Location: &dto.Location{X: 3, Y: 4, Site: 1}},
+	}
+	for i, w := range want {
+		got, ok := trace.Next()
+		if !ok {
+			t.Fatalf("trace has ended at arrival %d", i)
+		}
+		if got.Location != nil && w.Location != nil && *got.Location == *w.Location {
+			got.Location = w.Location
+		}
+		if *got != w {
+			t.Fatalf("arrival %d = %+v, want %+v", i, *got, w)
+		}
+	}
+	if _, ok := trace.Next(); ok {
+		t.Fatal("trace has more arrivals than submitted requests")
+	}
+}
@@ -7,9 +7,11 @@
 package cleaner
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,17 +23,158 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RequestStatus int32
+
+const (
+	RequestStatus_REQUEST_STATUS_UNSPECIFIED RequestStatus = 0
+	RequestStatus_REQUEST_QUEUED             RequestStatus = 1
+	RequestStatus_REQUEST_IN_PROGRESS        RequestStatus = 2
+	RequestStatus_REQUEST_COMPLETED          RequestStatus = 3
+	RequestStatus_REQUEST_REJECTED           RequestStatus = 4
+	RequestStatus_REQUEST_RENEGED            RequestStatus = 5
+	RequestStatus_REQUEST_BALKED             RequestStatus = 6
+	RequestStatus_REQUEST_FAILED             RequestStatus = 7
+	RequestStatus_REQUEST_SCHEDULED          RequestStatus = 8
+	RequestStatus_REQUEST_CANCELED           RequestStatus = 9
+)
+
+// Enum value maps for RequestStatus.
+var (
+	RequestStatus_name = map[int32]string{
+		0: "REQUEST_STATUS_UNSPECIFIED",
+		1: "REQUEST_QUEUED",
+		2: "REQUEST_IN_PROGRESS",
+		3: "REQUEST_COMPLETED",
+		4: "REQUEST_REJECTED",
+		5: "REQUEST_RENEGED",
+		6: "REQUEST_BALKED",
+		7: "REQUEST_FAILED",
+		8: "REQUEST_SCHEDULED",
+		9: "REQUEST_CANCELED",
+	}
+	RequestStatus_value = map[string]int32{
+		"REQUEST_STATUS_UNSPECIFIED": 0,
+		"REQUEST_QUEUED":             1,
+		"REQUEST_IN_PROGRESS":        2,
+		"REQUEST_COMPLETED":          3,
+		"REQUEST_REJECTED":           4,
+		"REQUEST_RENEGED":            5,
+		"REQUEST_BALKED":             6,
+		"REQUEST_FAILED":             7,
+		"REQUEST_SCHEDULED":          8,
+		"REQUEST_CANCELED":           9,
+	}
+)
+
+func (x RequestStatus) Enum() *RequestStatus {
+	p := new(RequestStatus)
+	*p = x
+	return p
+}
+
+func (x RequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cleaner_proto_enumTypes[0].Descriptor()
+}
+
+func (RequestStatus) Type() protoreflect.EnumType {
+	return &file_cleaner_proto_enumTypes[0]
+}
+
+func (x RequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestStatus.Descriptor instead.
+func (RequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{0}
+}
+
+type ActivityKind int32
+
+const (
+	ActivityKind_ACTIVITY_KIND_UNSPECIFIED ActivityKind = 0
+	ActivityKind_ACTIVITY_TRAVEL           ActivityKind = 1
+	ActivityKind_ACTIVITY_CLEANING         ActivityKind = 2
+	ActivityKind_ACTIVITY_RETURN           ActivityKind = 3
+	ActivityKind_ACTIVITY_REPAIR           ActivityKind = 4
+)
+
+// Enum value maps for ActivityKind.
+var (
+	ActivityKind_name = map[int32]string{
+		0: "ACTIVITY_KIND_UNSPECIFIED",
+		1: "ACTIVITY_TRAVEL",
+		2: "ACTIVITY_CLEANING",
+		3: "ACTIVITY_RETURN",
+		4: "ACTIVITY_REPAIR",
+	}
+	ActivityKind_value = map[string]int32{
+		"ACTIVITY_KIND_UNSPECIFIED": 0,
+		"ACTIVITY_TRAVEL":           1,
+		"ACTIVITY_CLEANING":         2,
+		"ACTIVITY_RETURN":           3,
+		"ACTIVITY_REPAIR":           4,
+	}
+)
+
+func (x ActivityKind) Enum() *ActivityKind {
+	p := new(ActivityKind)
+	*p = x
+	return p
+}
+
+func (x ActivityKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cleaner_proto_enumTypes[1].Descriptor()
+}
+
+func (ActivityKind) Type() protoreflect.EnumType {
+	return &file_cleaner_proto_enumTypes[1]
+}
+
+func (x ActivityKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityKind.Descriptor instead.
+func (ActivityKind) EnumDescriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{1}
+}
+
 type Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClientId      uint64   `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Priority      uint32   `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
-	CleaningType  uint32   `protobuf:"varint,4,opt,name=cleaning_type,json=cleaningType,proto3" json:"cleaning_type,omitempty"`
-	TeamId        *uint64  `protobuf:"varint,5,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
-	TimeInCleaner *float64 `protobuf:"fixed64,6,opt,name=time_in_cleaner,json=timeInCleaner,proto3,oneof" json:"time_in_cleaner,omitempty"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientId       uint64                 `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Priority       uint32                 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	CleaningType   uint32                 `protobuf:"varint,4,opt,name=cleaning_type,json=cleaningType,proto3" json:"cleaning_type,omitempty"`
+	TeamId         *uint64                `protobuf:"varint,5,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
+	TimeInCleaner  *float64               `protobuf:"fixed64,6,opt,name=time_in_cleaner,json=timeInCleaner,proto3,oneof" json:"time_in_cleaner,omitempty"`
+	Patience       *float64               `protobuf:"fixed64,7,opt,name=patience,proto3,oneof" json:"patience,omitempty"`
+	Deadline       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Status         RequestStatus          `protobuf:"varint,9,opt,name=status,proto3,enum=cleaner.RequestStatus" json:"status,omitempty"`
+	SubmittedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	WaitTime       *float64               `protobuf:"fixed64,11,opt,name=wait_time,json=waitTime,proto3,oneof" json:"wait_time,omitempty"`
+	FinishedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Interruptions  uint32                 `protobuf:"varint,13,opt,name=interruptions,proto3" json:"interruptions,omitempty"`
+	Stage          uint32                 `protobuf:"varint,14,opt,name=stage,proto3" json:"stage,omitempty"`      // stage is an index of request's current pipeline stage
+	Stages         []*StageTime           `protobuf:"bytes,15,rep,name=stages,proto3" json:"stages,omitempty"`     // stages are empty if request's type has no pipeline
+	Reworks        uint32                 `protobuf:"varint,16,opt,name=reworks,proto3" json:"reworks,omitempty"`  // reworks is an amount of times request has failed inspection
+	Location       *Location              `protobuf:"bytes,17,opt,name=location,proto3" json:"location,omitempty"` // location is a client's site, team does not travel to request without location
+	TravelTime     *float64               `protobuf:"fixed64,18,opt,name=travel_time,json=travelTime,proto3,oneof" json:"travel_time,omitempty"`
+	ScheduledAt    *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`           // scheduled_at is a booked start of scheduled request
+	SubscriptionId uint64                 `protobuf:"varint,20,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"` // subscription_id is zero if request is not an occurrence of subscription
+	Cost           float64                `protobuf:"fixed64,21,opt,name=cost,proto3" json:"cost,omitempty"`                                          // cost is a cost of teams' work on request
+	Revenue        float64                `protobuf:"fixed64,22,opt,name=revenue,proto3" json:"revenue,omitempty"`                                    // revenue is a price client has paid for completed request
 }
 
 func (x *Request) Reset() {
@@ -108,17 +251,131 @@ func (x *Request) GetTimeInCleaner() float64 {
 	return 0
 }
 
-type ProceedCleaningIn struct {
+func (x *Request) GetPatience() float64 {
+	if x != nil && x.Patience != nil {
+		return *x.Patience
+	}
+	return 0
+}
+
+func (x *Request) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Request) GetStatus() RequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RequestStatus_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *Request) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *Request) GetWaitTime() float64 {
+	if x != nil && x.WaitTime != nil {
+		return *x.WaitTime
+	}
+	return 0
+}
+
+func (x *Request) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *Request) GetInterruptions() uint32 {
+	if x != nil {
+		return x.Interruptions
+	}
+	return 0
+}
+
+func (x *Request) GetStage() uint32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *Request) GetStages() []*StageTime {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *Request) GetReworks() uint32 {
+	if x != nil {
+		return x.Reworks
+	}
+	return 0
+}
+
+func (x *Request) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Request) GetTravelTime() float64 {
+	if x != nil && x.TravelTime != nil {
+		return *x.TravelTime
+	}
+	return 0
+}
+
+func (x *Request) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *Request) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *Request) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *Request) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+// Location is a point on plane or a site of distance matrix
+type Location struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Req    *Request `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
-	TeamId uint64   `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	X    float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y    float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Site uint64  `protobuf:"varint,3,opt,name=site,proto3" json:"site,omitempty"`
 }
 
-func (x *ProceedCleaningIn) Reset() {
-	*x = ProceedCleaningIn{}
+func (x *Location) Reset() {
+	*x = Location{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cleaner_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -126,13 +383,13 @@ func (x *ProceedCleaningIn) Reset() {
 	}
 }
 
-func (x *ProceedCleaningIn) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProceedCleaningIn) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *ProceedCleaningIn) ProtoReflect() protoreflect.Message {
+func (x *Location) ProtoReflect() protoreflect.Message {
 	mi := &file_cleaner_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -144,35 +401,46 @@ func (x *ProceedCleaningIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProceedCleaningIn.ProtoReflect.Descriptor instead.
-func (*ProceedCleaningIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
 	return file_cleaner_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ProceedCleaningIn) GetReq() *Request {
+func (x *Location) GetX() float64 {
 	if x != nil {
-		return x.Req
+		return x.X
 	}
-	return nil
+	return 0
 }
 
-func (x *ProceedCleaningIn) GetTeamId() uint64 {
+func (x *Location) GetY() float64 {
 	if x != nil {
-		return x.TeamId
+		return x.Y
 	}
 	return 0
 }
 
-type ProceedCleaningOut struct {
+func (x *Location) GetSite() uint64 {
+	if x != nil {
+		return x.Site
+	}
+	return 0
+}
+
+// StageTime is a part of request's time spent on pipeline stage
+type StageTime struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Req *Request `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	Name          string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TeamId        uint64  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	WaitTime      float64 `protobuf:"fixed64,3,opt,name=wait_time,json=waitTime,proto3" json:"wait_time,omitempty"`
+	TimeInCleaner float64 `protobuf:"fixed64,4,opt,name=time_in_cleaner,json=timeInCleaner,proto3" json:"time_in_cleaner,omitempty"`
 }
 
-func (x *ProceedCleaningOut) Reset() {
-	*x = ProceedCleaningOut{}
+func (x *StageTime) Reset() {
+	*x = StageTime{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cleaner_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -180,13 +448,13 @@ func (x *ProceedCleaningOut) Reset() {
 	}
 }
 
-func (x *ProceedCleaningOut) String() string {
+func (x *StageTime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProceedCleaningOut) ProtoMessage() {}
+func (*StageTime) ProtoMessage() {}
 
-func (x *ProceedCleaningOut) ProtoReflect() protoreflect.Message {
+func (x *StageTime) ProtoReflect() protoreflect.Message {
 	mi := &file_cleaner_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -198,28 +466,50 @@ func (x *ProceedCleaningOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProceedCleaningOut.ProtoReflect.Descriptor instead.
-func (*ProceedCleaningOut) Descriptor() ([]byte, []int) {
+// Deprecated: Use StageTime.ProtoReflect.Descriptor instead.
+func (*StageTime) Descriptor() ([]byte, []int) {
 	return file_cleaner_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ProceedCleaningOut) GetReq() *Request {
+func (x *StageTime) GetName() string {
 	if x != nil {
-		return x.Req
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetAvailableTeamsOut struct {
+func (x *StageTime) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *StageTime) GetWaitTime() float64 {
+	if x != nil {
+		return x.WaitTime
+	}
+	return 0
+}
+
+func (x *StageTime) GetTimeInCleaner() float64 {
+	if x != nil {
+		return x.TimeInCleaner
+	}
+	return 0
+}
+
+type ProceedCleaningIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TeamsIds []uint64 `protobuf:"varint,1,rep,packed,name=teams_ids,json=teamsIds,proto3" json:"teams_ids,omitempty"`
+	Req    *Request `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	TeamId uint64   `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 }
 
-func (x *GetAvailableTeamsOut) Reset() {
-	*x = GetAvailableTeamsOut{}
+func (x *ProceedCleaningIn) Reset() {
+	*x = ProceedCleaningIn{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cleaner_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -227,13 +517,13 @@ func (x *GetAvailableTeamsOut) Reset() {
 	}
 }
 
-func (x *GetAvailableTeamsOut) String() string {
+func (x *ProceedCleaningIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableTeamsOut) ProtoMessage() {}
+func (*ProceedCleaningIn) ProtoMessage() {}
 
-func (x *GetAvailableTeamsOut) ProtoReflect() protoreflect.Message {
+func (x *ProceedCleaningIn) ProtoReflect() protoreflect.Message {
 	mi := &file_cleaner_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -245,31 +535,35 @@ func (x *GetAvailableTeamsOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableTeamsOut.ProtoReflect.Descriptor instead.
-func (*GetAvailableTeamsOut) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProceedCleaningIn.ProtoReflect.Descriptor instead.
+func (*ProceedCleaningIn) Descriptor() ([]byte, []int) {
 	return file_cleaner_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetAvailableTeamsOut) GetTeamsIds() []uint64 {
+func (x *ProceedCleaningIn) GetReq() *Request {
 	if x != nil {
-		return x.TeamsIds
+		return x.Req
 	}
 	return nil
 }
 
-type Team struct {
+func (x *ProceedCleaningIn) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type ProceedCleaningOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Speed             uint32  `protobuf:"varint,2,opt,name=speed,proto3" json:"speed,omitempty"`
-	ProcessedRequests uint64  `protobuf:"varint,3,opt,name=processed_requests,json=processedRequests,proto3" json:"processed_requests,omitempty"`
-	TotalBusyTime     float64 `protobuf:"fixed64,4,opt,name=total_busy_time,json=totalBusyTime,proto3" json:"total_busy_time,omitempty"`
+	Req *Request `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
 }
 
-func (x *Team) Reset() {
-	*x = Team{}
+func (x *ProceedCleaningOut) Reset() {
+	*x = ProceedCleaningOut{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cleaner_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -277,13 +571,13 @@ func (x *Team) Reset() {
 	}
 }
 
-func (x *Team) String() string {
+func (x *ProceedCleaningOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Team) ProtoMessage() {}
+func (*ProceedCleaningOut) ProtoMessage() {}
 
-func (x *Team) ProtoReflect() protoreflect.Message {
+func (x *ProceedCleaningOut) ProtoReflect() protoreflect.Message {
 	mi := &file_cleaner_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -295,49 +589,28 @@ func (x *Team) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Team.ProtoReflect.Descriptor instead.
-func (*Team) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProceedCleaningOut.ProtoReflect.Descriptor instead.
+func (*ProceedCleaningOut) Descriptor() ([]byte, []int) {
 	return file_cleaner_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Team) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Team) GetSpeed() uint32 {
-	if x != nil {
-		return x.Speed
-	}
-	return 0
-}
-
-func (x *Team) GetProcessedRequests() uint64 {
-	if x != nil {
-		return x.ProcessedRequests
-	}
-	return 0
-}
-
-func (x *Team) GetTotalBusyTime() float64 {
+func (x *ProceedCleaningOut) GetReq() *Request {
 	if x != nil {
-		return x.TotalBusyTime
+		return x.Req
 	}
-	return 0
+	return nil
 }
 
-type GetTeamsStatsOut struct {
+type SubmitCleaningIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Teams []*Team `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	Req *Request `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
 }
 
-func (x *GetTeamsStatsOut) Reset() {
-	*x = GetTeamsStatsOut{}
+func (x *SubmitCleaningIn) Reset() {
+	*x = SubmitCleaningIn{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cleaner_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -345,13 +618,13 @@ func (x *GetTeamsStatsOut) Reset() {
 	}
 }
 
-func (x *GetTeamsStatsOut) String() string {
+func (x *SubmitCleaningIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamsStatsOut) ProtoMessage() {}
+func (*SubmitCleaningIn) ProtoMessage() {}
 
-func (x *GetTeamsStatsOut) ProtoReflect() protoreflect.Message {
+func (x *SubmitCleaningIn) ProtoReflect() protoreflect.Message {
 	mi := &file_cleaner_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -363,81 +636,3380 @@ func (x *GetTeamsStatsOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamsStatsOut.ProtoReflect.Descriptor instead.
-func (*GetTeamsStatsOut) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitCleaningIn.ProtoReflect.Descriptor instead.
+func (*SubmitCleaningIn) Descriptor() ([]byte, []int) {
 	return file_cleaner_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetTeamsStatsOut) GetTeams() []*Team {
+func (x *SubmitCleaningIn) GetReq() *Request {
 	if x != nil {
-		return x.Teams
+		return x.Req
 	}
 	return nil
 }
 
-var File_cleaner_proto protoreflect.FileDescriptor
+type SubmitCleaningOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Req *Request `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+}
+
+func (x *SubmitCleaningOut) Reset() {
+	*x = SubmitCleaningOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitCleaningOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitCleaningOut) ProtoMessage() {}
+
+func (x *SubmitCleaningOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitCleaningOut.ProtoReflect.Descriptor instead.
+func (*SubmitCleaningOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubmitCleaningOut) GetReq() *Request {
+	if x != nil {
+		return x.Req
+	}
+	return nil
+}
+
+// ScheduleCleaningIn books request's cleaning starting inside [earliest_start, latest_start]
+type ScheduleCleaningIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Req           *Request               `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	EarliestStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=earliest_start,json=earliestStart,proto3" json:"earliest_start,omitempty"`
+	LatestStart   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=latest_start,json=latestStart,proto3" json:"latest_start,omitempty"`
+}
+
+func (x *ScheduleCleaningIn) Reset() {
+	*x = ScheduleCleaningIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleCleaningIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCleaningIn) ProtoMessage() {}
+
+func (x *ScheduleCleaningIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCleaningIn.ProtoReflect.Descriptor instead.
+func (*ScheduleCleaningIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScheduleCleaningIn) GetReq() *Request {
+	if x != nil {
+		return x.Req
+	}
+	return nil
+}
+
+func (x *ScheduleCleaningIn) GetEarliestStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EarliestStart
+	}
+	return nil
+}
+
+func (x *ScheduleCleaningIn) GetLatestStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LatestStart
+	}
+	return nil
+}
+
+type ScheduleCleaningOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Req *Request `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+}
+
+func (x *ScheduleCleaningOut) Reset() {
+	*x = ScheduleCleaningOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleCleaningOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCleaningOut) ProtoMessage() {}
+
+func (x *ScheduleCleaningOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCleaningOut.ProtoReflect.Descriptor instead.
+func (*ScheduleCleaningOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScheduleCleaningOut) GetReq() *Request {
+	if x != nil {
+		return x.Req
+	}
+	return nil
+}
+
+type GetFreeSlotsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CleaningType uint32                 `protobuf:"varint,1,opt,name=cleaning_type,json=cleaningType,proto3" json:"cleaning_type,omitempty"`
+	From         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GetFreeSlotsIn) Reset() {
+	*x = GetFreeSlotsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFreeSlotsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFreeSlotsIn) ProtoMessage() {}
+
+func (x *GetFreeSlotsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFreeSlotsIn.ProtoReflect.Descriptor instead.
+func (*GetFreeSlotsIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetFreeSlotsIn) GetCleaningType() uint32 {
+	if x != nil {
+		return x.CleaningType
+	}
+	return 0
+}
+
+func (x *GetFreeSlotsIn) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetFreeSlotsIn) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetFreeSlotsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slots []*FreeSlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *GetFreeSlotsOut) Reset() {
+	*x = GetFreeSlotsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFreeSlotsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFreeSlotsOut) ProtoMessage() {}
+
+func (x *GetFreeSlotsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFreeSlotsOut.ProtoReflect.Descriptor instead.
+func (*GetFreeSlotsOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetFreeSlotsOut) GetSlots() []*FreeSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// FreeSlot is a team's free interval, cleaning may be booked to start in it till end - duration
+type FreeSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId   uint64                 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Start    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Duration float64                `protobuf:"fixed64,4,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *FreeSlot) Reset() {
+	*x = FreeSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreeSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeSlot) ProtoMessage() {}
+
+func (x *FreeSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeSlot.ProtoReflect.Descriptor instead.
+func (*FreeSlot) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FreeSlot) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *FreeSlot) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *FreeSlot) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *FreeSlot) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+// Subscription is a client's recurring cleaning.
+// Occurrence's request id is subscription's id in high 32 bits and occurrence's number in low ones
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // id is in [1, 2^32), so it fits the high half of occurrences' requests' ids
+	ClientId     uint64                 `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	CleaningType uint32                 `protobuf:"varint,3,opt,name=cleaning_type,json=cleaningType,proto3" json:"cleaning_type,omitempty"`
+	Priority     uint32                 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Rule         string                 `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`       // rule is an RRULE-style recurrence, e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH"
+	Start        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`     // start is the first occurrence's start, now if empty
+	Window       float64                `protobuf:"fixed64,7,opt,name=window,proto3" json:"window,omitempty"` // window is how late in seconds occurrence's cleaning may start
+	Location     *Location              `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	SameTeam     bool                   `protobuf:"varint,9,opt,name=same_team,json=sameTeam,proto3" json:"same_team,omitempty"` // same_team makes occurrences prefer the team of the previous one
+	Canceled     bool                   `protobuf:"varint,10,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	Occurrences  []*Occurrence          `protobuf:"bytes,11,rep,name=occurrences,proto3" json:"occurrences,omitempty"` // occurrences are booked, skipped and rescheduled occurrences
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Subscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Subscription) GetClientId() uint64 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *Subscription) GetCleaningType() uint32 {
+	if x != nil {
+		return x.CleaningType
+	}
+	return 0
+}
+
+func (x *Subscription) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Subscription) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Subscription) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Subscription) GetWindow() float64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *Subscription) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Subscription) GetSameTeam() bool {
+	if x != nil {
+		return x.SameTeam
+	}
+	return false
+}
+
+func (x *Subscription) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
+	}
+	return false
+}
+
+func (x *Subscription) GetOccurrences() []*Occurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+type Occurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number  uint64                 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Start   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	Skipped bool                   `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Req     *Request               `protobuf:"bytes,4,opt,name=req,proto3" json:"req,omitempty"` // req is empty until occurrence is booked
+}
+
+func (x *Occurrence) Reset() {
+	*x = Occurrence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Occurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Occurrence) ProtoMessage() {}
+
+func (x *Occurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Occurrence.ProtoReflect.Descriptor instead.
+func (*Occurrence) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Occurrence) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Occurrence) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Occurrence) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *Occurrence) GetReq() *Request {
+	if x != nil {
+		return x.Req
+	}
+	return nil
+}
+
+type CreateSubscriptionIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *CreateSubscriptionIn) Reset() {
+	*x = CreateSubscriptionIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubscriptionIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionIn) ProtoMessage() {}
+
+func (x *CreateSubscriptionIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionIn.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateSubscriptionIn) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type CreateSubscriptionOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *CreateSubscriptionOut) Reset() {
+	*x = CreateSubscriptionOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubscriptionOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionOut) ProtoMessage() {}
+
+func (x *CreateSubscriptionOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionOut.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateSubscriptionOut) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type GetSubscriptionIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSubscriptionIn) Reset() {
+	*x = GetSubscriptionIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionIn) ProtoMessage() {}
+
+func (x *GetSubscriptionIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionIn.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetSubscriptionIn) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSubscriptionOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *GetSubscriptionOut) Reset() {
+	*x = GetSubscriptionOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionOut) ProtoMessage() {}
+
+func (x *GetSubscriptionOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionOut.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetSubscriptionOut) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type CancelSubscriptionIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelSubscriptionIn) Reset() {
+	*x = CancelSubscriptionIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSubscriptionIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionIn) ProtoMessage() {}
+
+func (x *CancelSubscriptionIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionIn.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelSubscriptionIn) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelSubscriptionOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *CancelSubscriptionOut) Reset() {
+	*x = CancelSubscriptionOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSubscriptionOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionOut) ProtoMessage() {}
+
+func (x *CancelSubscriptionOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionOut.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelSubscriptionOut) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type SkipOccurrenceIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Number         uint64 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *SkipOccurrenceIn) Reset() {
+	*x = SkipOccurrenceIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SkipOccurrenceIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipOccurrenceIn) ProtoMessage() {}
+
+func (x *SkipOccurrenceIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipOccurrenceIn.ProtoReflect.Descriptor instead.
+func (*SkipOccurrenceIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SkipOccurrenceIn) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *SkipOccurrenceIn) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type SkipOccurrenceOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Occurrence *Occurrence `protobuf:"bytes,1,opt,name=occurrence,proto3" json:"occurrence,omitempty"`
+}
+
+func (x *SkipOccurrenceOut) Reset() {
+	*x = SkipOccurrenceOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SkipOccurrenceOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipOccurrenceOut) ProtoMessage() {}
+
+func (x *SkipOccurrenceOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipOccurrenceOut.ProtoReflect.Descriptor instead.
+func (*SkipOccurrenceOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SkipOccurrenceOut) GetOccurrence() *Occurrence {
+	if x != nil {
+		return x.Occurrence
+	}
+	return nil
+}
+
+type RescheduleOccurrenceIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId uint64                 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Number         uint64                 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Start          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+}
+
+func (x *RescheduleOccurrenceIn) Reset() {
+	*x = RescheduleOccurrenceIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleOccurrenceIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleOccurrenceIn) ProtoMessage() {}
+
+func (x *RescheduleOccurrenceIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleOccurrenceIn.ProtoReflect.Descriptor instead.
+func (*RescheduleOccurrenceIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RescheduleOccurrenceIn) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *RescheduleOccurrenceIn) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *RescheduleOccurrenceIn) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+type RescheduleOccurrenceOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Occurrence *Occurrence `protobuf:"bytes,1,opt,name=occurrence,proto3" json:"occurrence,omitempty"`
+}
+
+func (x *RescheduleOccurrenceOut) Reset() {
+	*x = RescheduleOccurrenceOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleOccurrenceOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleOccurrenceOut) ProtoMessage() {}
+
+func (x *RescheduleOccurrenceOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleOccurrenceOut.ProtoReflect.Descriptor instead.
+func (*RescheduleOccurrenceOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RescheduleOccurrenceOut) GetOccurrence() *Occurrence {
+	if x != nil {
+		return x.Occurrence
+	}
+	return nil
+}
+
+type GetRequestIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRequestIn) Reset() {
+	*x = GetRequestIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequestIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestIn) ProtoMessage() {}
+
+func (x *GetRequestIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestIn.ProtoReflect.Descriptor instead.
+func (*GetRequestIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetRequestIn) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetRequestOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Req *Request `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+}
+
+func (x *GetRequestOut) Reset() {
+	*x = GetRequestOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequestOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestOut) ProtoMessage() {}
+
+func (x *GetRequestOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestOut.ProtoReflect.Descriptor instead.
+func (*GetRequestOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetRequestOut) GetReq() *Request {
+	if x != nil {
+		return x.Req
+	}
+	return nil
+}
+
+type Completion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Req  *Request               `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *Completion) Reset() {
+	*x = Completion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Completion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Completion) ProtoMessage() {}
+
+func (x *Completion) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Completion.ProtoReflect.Descriptor instead.
+func (*Completion) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Completion) GetReq() *Request {
+	if x != nil {
+		return x.Req
+	}
+	return nil
+}
+
+func (x *Completion) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type StreamEventsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"` // offset is a sequence number of the first event to stream, the earliest retained event if zero
+}
+
+func (x *StreamEventsIn) Reset() {
+	*x = StreamEventsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsIn) ProtoMessage() {}
+
+func (x *StreamEventsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsIn.ProtoReflect.Descriptor instead.
+func (*StreamEventsIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StreamEventsIn) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Event is a service event. Its type and team's status are stable names of event log's schema, e.g. "request_assigned"
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq            uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	TeamId         *uint64                `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"` // team_id is empty if event has happened to request only
+	TeamStatus     string                 `protobuf:"bytes,5,opt,name=team_status,json=teamStatus,proto3" json:"team_status,omitempty"`
+	AvailableTeams uint64                 `protobuf:"varint,6,opt,name=available_teams,json=availableTeams,proto3" json:"available_teams,omitempty"`
+	Request        *Request               `protobuf:"bytes,7,opt,name=request,proto3" json:"request,omitempty"` // request is empty if event has happened to team only
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Event) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetTeamId() uint64 {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return 0
+}
+
+func (x *Event) GetTeamStatus() string {
+	if x != nil {
+		return x.TeamStatus
+	}
+	return ""
+}
+
+func (x *Event) GetAvailableTeams() uint64 {
+	if x != nil {
+		return x.AvailableTeams
+	}
+	return 0
+}
+
+func (x *Event) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type GetTimelineIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId *uint64                `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"` // team_id is empty to get every team's activity
+	From   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                          // from is empty to get activity since the earliest retained interval
+	To     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                              // to is empty to get activity up to now
+}
+
+func (x *GetTimelineIn) Reset() {
+	*x = GetTimelineIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimelineIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineIn) ProtoMessage() {}
+
+func (x *GetTimelineIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineIn.ProtoReflect.Descriptor instead.
+func (*GetTimelineIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTimelineIn) GetTeamId() uint64 {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return 0
+}
+
+func (x *GetTimelineIn) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTimelineIn) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetTimelineOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Intervals []*Interval `protobuf:"bytes,1,rep,name=intervals,proto3" json:"intervals,omitempty"` // intervals are in order of their start
+}
+
+func (x *GetTimelineOut) Reset() {
+	*x = GetTimelineOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimelineOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineOut) ProtoMessage() {}
+
+func (x *GetTimelineOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineOut.ProtoReflect.Descriptor instead.
+func (*GetTimelineOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTimelineOut) GetIntervals() []*Interval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+// Interval is a period of team's activity
+type Interval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId    uint64                 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	RequestId *uint64                `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"` // request_id is empty if activity is not related to request, e.g. repair
+	Kind      ActivityKind           `protobuf:"varint,3,opt,name=kind,proto3,enum=cleaner.ActivityKind" json:"kind,omitempty"`
+	Start     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Ongoing   bool                   `protobuf:"varint,6,opt,name=ongoing,proto3" json:"ongoing,omitempty"` // ongoing interval has not ended yet, its end is the current time
+}
+
+func (x *Interval) Reset() {
+	*x = Interval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interval) ProtoMessage() {}
+
+func (x *Interval) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interval.ProtoReflect.Descriptor instead.
+func (*Interval) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Interval) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Interval) GetRequestId() uint64 {
+	if x != nil && x.RequestId != nil {
+		return *x.RequestId
+	}
+	return 0
+}
+
+func (x *Interval) GetKind() ActivityKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ActivityKind_ACTIVITY_KIND_UNSPECIFIED
+}
+
+func (x *Interval) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Interval) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Interval) GetOngoing() bool {
+	if x != nil {
+		return x.Ongoing
+	}
+	return false
+}
+
+type GetAvailableTeamsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CleaningType *uint32 `protobuf:"varint,1,opt,name=cleaning_type,json=cleaningType,proto3,oneof" json:"cleaning_type,omitempty"`
+}
+
+func (x *GetAvailableTeamsIn) Reset() {
+	*x = GetAvailableTeamsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAvailableTeamsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableTeamsIn) ProtoMessage() {}
+
+func (x *GetAvailableTeamsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableTeamsIn.ProtoReflect.Descriptor instead.
+func (*GetAvailableTeamsIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetAvailableTeamsIn) GetCleaningType() uint32 {
+	if x != nil && x.CleaningType != nil {
+		return *x.CleaningType
+	}
+	return 0
+}
+
+type GetAvailableTeamsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamsIds  []uint64 `protobuf:"varint,1,rep,packed,name=teams_ids,json=teamsIds,proto3" json:"teams_ids,omitempty"`
+	FreeSlots []uint64 `protobuf:"varint,2,rep,packed,name=free_slots,json=freeSlots,proto3" json:"free_slots,omitempty"` // free_slots are amounts of requests teams are able to take now, in order of teams_ids
+}
+
+func (x *GetAvailableTeamsOut) Reset() {
+	*x = GetAvailableTeamsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAvailableTeamsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableTeamsOut) ProtoMessage() {}
+
+func (x *GetAvailableTeamsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableTeamsOut.ProtoReflect.Descriptor instead.
+func (*GetAvailableTeamsOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetAvailableTeamsOut) GetTeamsIds() []uint64 {
+	if x != nil {
+		return x.TeamsIds
+	}
+	return nil
+}
+
+func (x *GetAvailableTeamsOut) GetFreeSlots() []uint64 {
+	if x != nil {
+		return x.FreeSlots
+	}
+	return nil
+}
+
+type Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Speed              uint32             `protobuf:"varint,2,opt,name=speed,proto3" json:"speed,omitempty"`
+	ProcessedRequests  uint64             `protobuf:"varint,3,opt,name=processed_requests,json=processedRequests,proto3" json:"processed_requests,omitempty"`
+	TotalBusyTime      float64            `protobuf:"fixed64,4,opt,name=total_busy_time,json=totalBusyTime,proto3" json:"total_busy_time,omitempty"`
+	Utilization        float64            `protobuf:"fixed64,5,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	Status             uint32             `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Failures           uint64             `protobuf:"varint,7,opt,name=failures,proto3" json:"failures,omitempty"`
+	DownTime           float64            `protobuf:"fixed64,8,opt,name=down_time,json=downTime,proto3" json:"down_time,omitempty"`
+	Availability       float64            `protobuf:"fixed64,9,opt,name=availability,proto3" json:"availability,omitempty"`
+	OnShift            bool               `protobuf:"varint,10,opt,name=on_shift,json=onShift,proto3" json:"on_shift,omitempty"`
+	ShiftTime          float64            `protobuf:"fixed64,11,opt,name=shift_time,json=shiftTime,proto3" json:"shift_time,omitempty"`
+	OnShiftUtilization float64            `protobuf:"fixed64,12,opt,name=on_shift_utilization,json=onShiftUtilization,proto3" json:"on_shift_utilization,omitempty"`
+	Overtime           float64            `protobuf:"fixed64,13,opt,name=overtime,proto3" json:"overtime,omitempty"`
+	Handoffs           uint64             `protobuf:"varint,14,opt,name=handoffs,proto3" json:"handoffs,omitempty"`
+	Skills             map[uint32]float64 `protobuf:"bytes,15,rep,name=skills,proto3" json:"skills,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Capacity           uint64             `protobuf:"varint,16,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	FreeSlots          uint64             `protobuf:"varint,17,opt,name=free_slots,json=freeSlots,proto3" json:"free_slots,omitempty"`
+	ActiveRequests     []uint64           `protobuf:"varint,18,rep,packed,name=active_requests,json=activeRequests,proto3" json:"active_requests,omitempty"`
+	Reworks            uint64             `protobuf:"varint,19,opt,name=reworks,proto3" json:"reworks,omitempty"`
+	ReworkRate         float64            `protobuf:"fixed64,20,opt,name=rework_rate,json=reworkRate,proto3" json:"rework_rate,omitempty"`
+	TravelTime         float64            `protobuf:"fixed64,21,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
+	Position           *Location          `protobuf:"bytes,22,opt,name=position,proto3" json:"position,omitempty"`
+	Cost               float64            `protobuf:"fixed64,23,opt,name=cost,proto3" json:"cost,omitempty"`       // cost is a cost of team's paid time, idle one included
+	Revenue            float64            `protobuf:"fixed64,24,opt,name=revenue,proto3" json:"revenue,omitempty"` // revenue is a sum of prices of requests completed by team
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Team) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Team) GetSpeed() uint32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *Team) GetProcessedRequests() uint64 {
+	if x != nil {
+		return x.ProcessedRequests
+	}
+	return 0
+}
+
+func (x *Team) GetTotalBusyTime() float64 {
+	if x != nil {
+		return x.TotalBusyTime
+	}
+	return 0
+}
+
+func (x *Team) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *Team) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Team) GetFailures() uint64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *Team) GetDownTime() float64 {
+	if x != nil {
+		return x.DownTime
+	}
+	return 0
+}
+
+func (x *Team) GetAvailability() float64 {
+	if x != nil {
+		return x.Availability
+	}
+	return 0
+}
+
+func (x *Team) GetOnShift() bool {
+	if x != nil {
+		return x.OnShift
+	}
+	return false
+}
+
+func (x *Team) GetShiftTime() float64 {
+	if x != nil {
+		return x.ShiftTime
+	}
+	return 0
+}
+
+func (x *Team) GetOnShiftUtilization() float64 {
+	if x != nil {
+		return x.OnShiftUtilization
+	}
+	return 0
+}
+
+func (x *Team) GetOvertime() float64 {
+	if x != nil {
+		return x.Overtime
+	}
+	return 0
+}
+
+func (x *Team) GetHandoffs() uint64 {
+	if x != nil {
+		return x.Handoffs
+	}
+	return 0
+}
+
+func (x *Team) GetSkills() map[uint32]float64 {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *Team) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Team) GetFreeSlots() uint64 {
+	if x != nil {
+		return x.FreeSlots
+	}
+	return 0
+}
+
+func (x *Team) GetActiveRequests() []uint64 {
+	if x != nil {
+		return x.ActiveRequests
+	}
+	return nil
+}
+
+func (x *Team) GetReworks() uint64 {
+	if x != nil {
+		return x.Reworks
+	}
+	return 0
+}
+
+func (x *Team) GetReworkRate() float64 {
+	if x != nil {
+		return x.ReworkRate
+	}
+	return 0
+}
+
+func (x *Team) GetTravelTime() float64 {
+	if x != nil {
+		return x.TravelTime
+	}
+	return 0
+}
+
+func (x *Team) GetPosition() *Location {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Team) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *Team) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+type GetTeamsStatsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Teams []*Team `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+}
+
+func (x *GetTeamsStatsOut) Reset() {
+	*x = GetTeamsStatsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTeamsStatsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsStatsOut) ProtoMessage() {}
+
+func (x *GetTeamsStatsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsStatsOut.ProtoReflect.Descriptor instead.
+func (*GetTeamsStatsOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTeamsStatsOut) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type GetClientStatsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId *uint64 `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"` // client_id is empty to get statistics of every known client
+}
+
+func (x *GetClientStatsIn) Reset() {
+	*x = GetClientStatsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClientStatsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientStatsIn) ProtoMessage() {}
+
+func (x *GetClientStatsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientStatsIn.ProtoReflect.Descriptor instead.
+func (*GetClientStatsIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetClientStatsIn) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+type GetClientStatsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clients []*ClientStats `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *GetClientStatsOut) Reset() {
+	*x = GetClientStatsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClientStatsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientStatsOut) ProtoMessage() {}
+
+func (x *GetClientStatsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientStatsOut.ProtoReflect.Descriptor instead.
+func (*GetClientStatsOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetClientStatsOut) GetClients() []*ClientStats {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+// ClientStats are statistics of client's requests since statistics reset
+type ClientStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId         uint64  `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Weight           float64 `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"` // weight is a client's share of teams under fair queueing
+	Arrivals         uint64  `protobuf:"varint,3,opt,name=arrivals,proto3" json:"arrivals,omitempty"`
+	Completed        uint64  `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Rejected         uint64  `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected,omitempty"` // rejected are requests rejected, balked or rate limited
+	Reneged          uint64  `protobuf:"varint,6,opt,name=reneged,proto3" json:"reneged,omitempty"`
+	Waiting          uint64  `protobuf:"varint,7,opt,name=waiting,proto3" json:"waiting,omitempty"`
+	MeanWaitTime     float64 `protobuf:"fixed64,8,opt,name=mean_wait_time,json=meanWaitTime,proto3" json:"mean_wait_time,omitempty"`
+	MeanCleaningTime float64 `protobuf:"fixed64,9,opt,name=mean_cleaning_time,json=meanCleaningTime,proto3" json:"mean_cleaning_time,omitempty"`
+	MeanResponseTime float64 `protobuf:"fixed64,10,opt,name=mean_response_time,json=meanResponseTime,proto3" json:"mean_response_time,omitempty"`
+}
+
+func (x *ClientStats) Reset() {
+	*x = ClientStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientStats) ProtoMessage() {}
+
+func (x *ClientStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientStats.ProtoReflect.Descriptor instead.
+func (*ClientStats) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ClientStats) GetClientId() uint64 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *ClientStats) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ClientStats) GetArrivals() uint64 {
+	if x != nil {
+		return x.Arrivals
+	}
+	return 0
+}
+
+func (x *ClientStats) GetCompleted() uint64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *ClientStats) GetRejected() uint64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ClientStats) GetReneged() uint64 {
+	if x != nil {
+		return x.Reneged
+	}
+	return 0
+}
+
+func (x *ClientStats) GetWaiting() uint64 {
+	if x != nil {
+		return x.Waiting
+	}
+	return 0
+}
+
+func (x *ClientStats) GetMeanWaitTime() float64 {
+	if x != nil {
+		return x.MeanWaitTime
+	}
+	return 0
+}
+
+func (x *ClientStats) GetMeanCleaningTime() float64 {
+	if x != nil {
+		return x.MeanCleaningTime
+	}
+	return 0
+}
+
+func (x *ClientStats) GetMeanResponseTime() float64 {
+	if x != nil {
+		return x.MeanResponseTime
+	}
+	return 0
+}
+
+type GetSystemStatsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elapsed            float64       `protobuf:"fixed64,1,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+	Arrivals           uint64        `protobuf:"varint,2,opt,name=arrivals,proto3" json:"arrivals,omitempty"`
+	Started            uint64        `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"`
+	Completed          uint64        `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	QueueLength        uint64        `protobuf:"varint,5,opt,name=queue_length,json=queueLength,proto3" json:"queue_length,omitempty"`
+	MaxQueueLength     uint64        `protobuf:"varint,6,opt,name=max_queue_length,json=maxQueueLength,proto3" json:"max_queue_length,omitempty"`
+	MeanQueueLength    float64       `protobuf:"fixed64,7,opt,name=mean_queue_length,json=meanQueueLength,proto3" json:"mean_queue_length,omitempty"`
+	MeanWaitTime       float64       `protobuf:"fixed64,8,opt,name=mean_wait_time,json=meanWaitTime,proto3" json:"mean_wait_time,omitempty"`
+	MeanResponseTime   float64       `protobuf:"fixed64,9,opt,name=mean_response_time,json=meanResponseTime,proto3" json:"mean_response_time,omitempty"`
+	Throughput         float64       `protobuf:"fixed64,10,opt,name=throughput,proto3" json:"throughput,omitempty"`
+	Utilization        float64       `protobuf:"fixed64,11,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	Rejected           uint64        `protobuf:"varint,12,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Reneged            uint64        `protobuf:"varint,13,opt,name=reneged,proto3" json:"reneged,omitempty"`
+	Balked             uint64        `protobuf:"varint,14,opt,name=balked,proto3" json:"balked,omitempty"`
+	Interrupted        uint64        `protobuf:"varint,15,opt,name=interrupted,proto3" json:"interrupted,omitempty"`
+	Failed             uint64        `protobuf:"varint,16,opt,name=failed,proto3" json:"failed,omitempty"`
+	Availability       float64       `protobuf:"fixed64,17,opt,name=availability,proto3" json:"availability,omitempty"`
+	Handoffs           uint64        `protobuf:"varint,18,opt,name=handoffs,proto3" json:"handoffs,omitempty"`
+	Overtime           float64       `protobuf:"fixed64,19,opt,name=overtime,proto3" json:"overtime,omitempty"`
+	OnShiftUtilization float64       `protobuf:"fixed64,20,opt,name=on_shift_utilization,json=onShiftUtilization,proto3" json:"on_shift_utilization,omitempty"`
+	Stages             []*StageStats `protobuf:"bytes,21,rep,name=stages,proto3" json:"stages,omitempty"`
+	Reworked           uint64        `protobuf:"varint,22,opt,name=reworked,proto3" json:"reworked,omitempty"`
+	ReworkRate         float64       `protobuf:"fixed64,23,opt,name=rework_rate,json=reworkRate,proto3" json:"rework_rate,omitempty"`
+	TravelTime         float64       `protobuf:"fixed64,24,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
+	MeanTravelTime     float64       `protobuf:"fixed64,25,opt,name=mean_travel_time,json=meanTravelTime,proto3" json:"mean_travel_time,omitempty"`
+	Scheduled          uint64        `protobuf:"varint,26,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	Canceled           uint64        `protobuf:"varint,27,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	Cost               float64       `protobuf:"fixed64,28,opt,name=cost,proto3" json:"cost,omitempty"`       // cost is a total cost of teams' paid time
+	Revenue            float64       `protobuf:"fixed64,29,opt,name=revenue,proto3" json:"revenue,omitempty"` // revenue is a total price of completed requests
+	Profit             float64       `protobuf:"fixed64,30,opt,name=profit,proto3" json:"profit,omitempty"`
+}
+
+func (x *GetSystemStatsOut) Reset() {
+	*x = GetSystemStatsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSystemStatsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemStatsOut) ProtoMessage() {}
+
+func (x *GetSystemStatsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemStatsOut.ProtoReflect.Descriptor instead.
+func (*GetSystemStatsOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetSystemStatsOut) GetElapsed() float64 {
+	if x != nil {
+		return x.Elapsed
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetArrivals() uint64 {
+	if x != nil {
+		return x.Arrivals
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetStarted() uint64 {
+	if x != nil {
+		return x.Started
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetCompleted() uint64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetQueueLength() uint64 {
+	if x != nil {
+		return x.QueueLength
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetMaxQueueLength() uint64 {
+	if x != nil {
+		return x.MaxQueueLength
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetMeanQueueLength() float64 {
+	if x != nil {
+		return x.MeanQueueLength
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetMeanWaitTime() float64 {
+	if x != nil {
+		return x.MeanWaitTime
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetMeanResponseTime() float64 {
+	if x != nil {
+		return x.MeanResponseTime
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetThroughput() float64 {
+	if x != nil {
+		return x.Throughput
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetRejected() uint64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetReneged() uint64 {
+	if x != nil {
+		return x.Reneged
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetBalked() uint64 {
+	if x != nil {
+		return x.Balked
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetInterrupted() uint64 {
+	if x != nil {
+		return x.Interrupted
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetAvailability() float64 {
+	if x != nil {
+		return x.Availability
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetHandoffs() uint64 {
+	if x != nil {
+		return x.Handoffs
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetOvertime() float64 {
+	if x != nil {
+		return x.Overtime
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetOnShiftUtilization() float64 {
+	if x != nil {
+		return x.OnShiftUtilization
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetStages() []*StageStats {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *GetSystemStatsOut) GetReworked() uint64 {
+	if x != nil {
+		return x.Reworked
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetReworkRate() float64 {
+	if x != nil {
+		return x.ReworkRate
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetTravelTime() float64 {
+	if x != nil {
+		return x.TravelTime
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetMeanTravelTime() float64 {
+	if x != nil {
+		return x.MeanTravelTime
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetScheduled() uint64 {
+	if x != nil {
+		return x.Scheduled
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetCanceled() uint64 {
+	if x != nil {
+		return x.Canceled
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *GetSystemStatsOut) GetProfit() float64 {
+	if x != nil {
+		return x.Profit
+	}
+	return 0
+}
+
+// StageStats are statistics of pipeline stages with the same name
+type StageStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Completed         uint64  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	MeanWaitTime      float64 `protobuf:"fixed64,3,opt,name=mean_wait_time,json=meanWaitTime,proto3" json:"mean_wait_time,omitempty"`
+	MeanTimeInCleaner float64 `protobuf:"fixed64,4,opt,name=mean_time_in_cleaner,json=meanTimeInCleaner,proto3" json:"mean_time_in_cleaner,omitempty"`
+}
+
+func (x *StageStats) Reset() {
+	*x = StageStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageStats) ProtoMessage() {}
+
+func (x *StageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageStats.ProtoReflect.Descriptor instead.
+func (*StageStats) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StageStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StageStats) GetCompleted() uint64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *StageStats) GetMeanWaitTime() float64 {
+	if x != nil {
+		return x.MeanWaitTime
+	}
+	return 0
+}
+
+func (x *StageStats) GetMeanTimeInCleaner() float64 {
+	if x != nil {
+		return x.MeanTimeInCleaner
+	}
+	return 0
+}
+
+type GetTheoreticalMetricsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArrivalRate   *float64 `protobuf:"fixed64,1,opt,name=arrival_rate,json=arrivalRate,proto3,oneof" json:"arrival_rate,omitempty"`
+	QueueCapacity *uint64  `protobuf:"varint,2,opt,name=queue_capacity,json=queueCapacity,proto3,oneof" json:"queue_capacity,omitempty"`
+	ServiceScv    *float64 `protobuf:"fixed64,3,opt,name=service_scv,json=serviceScv,proto3,oneof" json:"service_scv,omitempty"`
+}
+
+func (x *GetTheoreticalMetricsIn) Reset() {
+	*x = GetTheoreticalMetricsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTheoreticalMetricsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTheoreticalMetricsIn) ProtoMessage() {}
+
+func (x *GetTheoreticalMetricsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTheoreticalMetricsIn.ProtoReflect.Descriptor instead.
+func (*GetTheoreticalMetricsIn) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetTheoreticalMetricsIn) GetArrivalRate() float64 {
+	if x != nil && x.ArrivalRate != nil {
+		return *x.ArrivalRate
+	}
+	return 0
+}
+
+func (x *GetTheoreticalMetricsIn) GetQueueCapacity() uint64 {
+	if x != nil && x.QueueCapacity != nil {
+		return *x.QueueCapacity
+	}
+	return 0
+}
+
+func (x *GetTheoreticalMetricsIn) GetServiceScv() float64 {
+	if x != nil && x.ServiceScv != nil {
+		return *x.ServiceScv
+	}
+	return 0
+}
+
+type TheoreticalModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model               string  `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Error               string  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Utilization         float64 `protobuf:"fixed64,3,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	WaitProbability     float64 `protobuf:"fixed64,4,opt,name=wait_probability,json=waitProbability,proto3" json:"wait_probability,omitempty"`
+	BlockingProbability float64 `protobuf:"fixed64,5,opt,name=blocking_probability,json=blockingProbability,proto3" json:"blocking_probability,omitempty"`
+	MeanQueueLength     float64 `protobuf:"fixed64,6,opt,name=mean_queue_length,json=meanQueueLength,proto3" json:"mean_queue_length,omitempty"`
+	MeanInSystem        float64 `protobuf:"fixed64,7,opt,name=mean_in_system,json=meanInSystem,proto3" json:"mean_in_system,omitempty"`
+	MeanWaitTime        float64 `protobuf:"fixed64,8,opt,name=mean_wait_time,json=meanWaitTime,proto3" json:"mean_wait_time,omitempty"`
+	MeanResponseTime    float64 `protobuf:"fixed64,9,opt,name=mean_response_time,json=meanResponseTime,proto3" json:"mean_response_time,omitempty"`
+	Throughput          float64 `protobuf:"fixed64,10,opt,name=throughput,proto3" json:"throughput,omitempty"`
+}
+
+func (x *TheoreticalModel) Reset() {
+	*x = TheoreticalModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TheoreticalModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TheoreticalModel) ProtoMessage() {}
+
+func (x *TheoreticalModel) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TheoreticalModel.ProtoReflect.Descriptor instead.
+func (*TheoreticalModel) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TheoreticalModel) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *TheoreticalModel) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TheoreticalModel) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *TheoreticalModel) GetWaitProbability() float64 {
+	if x != nil {
+		return x.WaitProbability
+	}
+	return 0
+}
+
+func (x *TheoreticalModel) GetBlockingProbability() float64 {
+	if x != nil {
+		return x.BlockingProbability
+	}
+	return 0
+}
+
+func (x *TheoreticalModel) GetMeanQueueLength() float64 {
+	if x != nil {
+		return x.MeanQueueLength
+	}
+	return 0
+}
+
+func (x *TheoreticalModel) GetMeanInSystem() float64 {
+	if x != nil {
+		return x.MeanInSystem
+	}
+	return 0
+}
+
+func (x *TheoreticalModel) GetMeanWaitTime() float64 {
+	if x != nil {
+		return x.MeanWaitTime
+	}
+	return 0
+}
+
+func (x *TheoreticalModel) GetMeanResponseTime() float64 {
+	if x != nil {
+		return x.MeanResponseTime
+	}
+	return 0
+}
+
+func (x *TheoreticalModel) GetThroughput() float64 {
+	if x != nil {
+		return x.Throughput
+	}
+	return 0
+}
+
+type GetTheoreticalMetricsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArrivalRate     float64             `protobuf:"fixed64,1,opt,name=arrival_rate,json=arrivalRate,proto3" json:"arrival_rate,omitempty"`
+	MeanServiceRate float64             `protobuf:"fixed64,2,opt,name=mean_service_rate,json=meanServiceRate,proto3" json:"mean_service_rate,omitempty"`
+	Teams           uint64              `protobuf:"varint,3,opt,name=teams,proto3" json:"teams,omitempty"`
+	Models          []*TheoreticalModel `protobuf:"bytes,4,rep,name=models,proto3" json:"models,omitempty"`
+}
+
+func (x *GetTheoreticalMetricsOut) Reset() {
+	*x = GetTheoreticalMetricsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cleaner_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTheoreticalMetricsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTheoreticalMetricsOut) ProtoMessage() {}
+
+func (x *GetTheoreticalMetricsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_cleaner_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTheoreticalMetricsOut.ProtoReflect.Descriptor instead.
+func (*GetTheoreticalMetricsOut) Descriptor() ([]byte, []int) {
+	return file_cleaner_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetTheoreticalMetricsOut) GetArrivalRate() float64 {
+	if x != nil {
+		return x.ArrivalRate
+	}
+	return 0
+}
+
+func (x *GetTheoreticalMetricsOut) GetMeanServiceRate() float64 {
+	if x != nil {
+		return x.MeanServiceRate
+	}
+	return 0
+}
+
+func (x *GetTheoreticalMetricsOut) GetTeams() uint64 {
+	if x != nil {
+		return x.Teams
+	}
+	return 0
+}
+
+func (x *GetTheoreticalMetricsOut) GetModels() []*TheoreticalModel {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+var File_cleaner_proto protoreflect.FileDescriptor
 
 var file_cleaner_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x07, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c,
-	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1c, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a,
-	0x0f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e,
-	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74,
-	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x11, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x65, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x12,
-	0x22, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
-	0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03,
-	0x72, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x12,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x4f,
-	0x75, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x03, 0x72, 0x65, 0x71, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x04, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x49, 0x64, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x04,
-	0x54, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x62, 0x75, 0x73, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x73, 0x79, 0x54, 0x69, 0x6d,
-	0x65, 0x22, 0x37, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x54,
-	0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x32, 0xec, 0x01, 0x0a, 0x0e, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a,
-	0x0f, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
-	0x12, 0x1a, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x65, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x1a, 0x1b, 0x2e, 0x63,
-	0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x12, 0x4a, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x16,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x07, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x74,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x08, 0x70,
+	0x61, 0x74, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x08, 0x77, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63,
+	0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77,
+	0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x69, 0x74, 0x65, 0x22, 0x7d, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x77, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x72, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x72,
+	0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x72, 0x65, 0x71, 0x22,
+	0x36, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x03, 0x72, 0x65, 0x71, 0x22, 0x37, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x03,
+	0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x72, 0x65, 0x71,
+	0x22, 0xba, 0x01, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x72, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0e, 0x65,
+	0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0d, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3d,
+	0x0a, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0x39, 0x0a,
+	0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x03, 0x72, 0x65, 0x71, 0x22, 0x91, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x46, 0x72, 0x65, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x3a, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x53, 0x6c, 0x6f,
+	0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x08, 0x46, 0x72, 0x65,
+	0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x02, 0x0a, 0x0c, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x30, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2d, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x61, 0x6d, 0x65, 0x5f, 0x74,
+	0x65, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x65, 0x54,
+	0x65, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x12,
+	0x35, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x4f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x4f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x71,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x72, 0x65, 0x71, 0x22, 0x51, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x39, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x52, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12,
+	0x39, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x52, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x10, 0x53, 0x6b, 0x69, 0x70, 0x4f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x11, 0x53,
+	0x6b, 0x69, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x75, 0x74,
+	0x12, 0x33, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x4f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x22, 0x4e, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x33,
+	0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0x1e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x03, 0x72, 0x65, 0x71, 0x22, 0x60, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x72, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0xfd, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x61,
-	0x6d, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x2a, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x65, 0x61,
+	0x6d, 0x5f, 0x69, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x12, 0x1c, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x2f,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x22,
+	0xfb, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65,
+	0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x51, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x49, 0x6e, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0c, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x52, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d,
+	0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x61,
+	0x6d, 0x73, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x53,
+	0x6c, 0x6f, 0x74, 0x73, 0x22, 0xc9, 0x06, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x73, 0x79,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x42, 0x75, 0x73, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x74,
+	0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x73, 0x68, 0x69, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6f,
+	0x6e, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6f, 0x6e, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x6e,
+	0x64, 0x6f, 0x66, 0x66, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x68, 0x61, 0x6e,
+	0x64, 0x6f, 0x66, 0x66, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18,
+	0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e,
+	0x54, 0x65, 0x61, 0x6d, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x53, 0x6c,
+	0x6f, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65,
+	0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x65,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x37, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x4f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x65,
+	0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x20, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x43, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f,
+	0x75, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xce, 0x02, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x6e, 0x65, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x72, 0x65, 0x6e, 0x65, 0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x6e, 0x57,
+	0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x5f,
+	0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xdb, 0x07, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x65, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61,
+	0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x6e, 0x57, 0x61,
+	0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70,
+	0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6e, 0x65, 0x67, 0x65, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x65, 0x6e, 0x65, 0x67, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x61, 0x6c, 0x6b, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x61,
+	0x6c, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70,
+	0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x72, 0x75, 0x70, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x73, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x6e,
+	0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6f, 0x6e, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x5f,
+	0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x74, 0x22, 0x95, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x6e,
+	0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x65, 0x61, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x49, 0x6e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x22, 0xc7, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x49, 0x6e, 0x12, 0x26, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x61,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x0e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x63, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02,
+	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x63, 0x76, 0x88, 0x01, 0x01, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x73, 0x63, 0x76, 0x22, 0x84, 0x03, 0x0a, 0x10, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x31, 0x0a, 0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72,
+	0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x13, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x6e, 0x49, 0x6e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x77,
+	0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x6d, 0x65, 0x61, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68,
+	0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x06,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2a,
+	0xf3, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x51, 0x55, 0x45,
+	0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x4e, 0x45, 0x47, 0x45, 0x44, 0x10, 0x05,
+	0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x42, 0x41, 0x4c, 0x4b,
+	0x45, 0x44, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x08, 0x12,
+	0x14, 0x0a, 0x10, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x45, 0x44, 0x10, 0x09, 0x2a, 0x83, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49,
+	0x54, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54,
+	0x59, 0x5f, 0x54, 0x52, 0x41, 0x56, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45,
+	0x54, 0x55, 0x52, 0x4e, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49,
+	0x54, 0x59, 0x5f, 0x52, 0x45, 0x50, 0x41, 0x49, 0x52, 0x10, 0x04, 0x32, 0x83, 0x0f, 0x0a, 0x0e,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64,
+	0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x1a, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x65, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x1a, 0x1b, 0x2e,
+	0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x60, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x22, 0x17, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x67, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x1a, 0x1c, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65,
+	0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69,
+	0x6e, 0x67, 0x4f, 0x75, 0x74, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a,
+	0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x54, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12,
+	0x17, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65,
+	0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x4f,
+	0x75, 0x74, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x71, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x1e, 0x2e, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x75, 0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x73, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x1e, 0x2e, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8e, 0x01, 0x0a, 0x0e, 0x53, 0x6b,
+	0x69, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65,
+	0x72, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x4f, 0x75, 0x74, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x22, 0x3d, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f, 0x73, 0x6b, 0x69, 0x70, 0x12, 0xa9, 0x01, 0x0a, 0x14, 0x52,
+	0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x6e, 0x1a, 0x20, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x48, 0x3a, 0x01,
+	0x2a, 0x22, 0x43, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x56, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4f, 0x75, 0x74, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5b,
+	0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0c, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49,
+	0x6e, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x6d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x49, 0x6e, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x5b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d,
 	0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19,
 	0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x61, 0x7a, 0x68, 0x65, 0x6e, 0x61, 0x74,
-	0x6f, 0x72, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x62, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x6e, 0x1a,
+	0x1a, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x11, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x70, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63, 0x61,
+	0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x6c, 0x65, 0x61, 0x6e,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69, 0x63, 0x61,
+	0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x1a, 0x21, 0x2e, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x65, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x12, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x68, 0x65, 0x6f, 0x72,
+	0x79, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x42, 0x61, 0x7a, 0x68, 0x65, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e,
+	0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -452,31 +4024,150 @@ func file_cleaner_proto_rawDescGZIP() []byte {
 	return file_cleaner_proto_rawDescData
 }
 
-var file_cleaner_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cleaner_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cleaner_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_cleaner_proto_goTypes = []interface{}{
-	(*Request)(nil),              // 0: cleaner.Request
-	(*ProceedCleaningIn)(nil),    // 1: cleaner.ProceedCleaningIn
-	(*ProceedCleaningOut)(nil),   // 2: cleaner.ProceedCleaningOut
-	(*GetAvailableTeamsOut)(nil), // 3: cleaner.GetAvailableTeamsOut
-	(*Team)(nil),                 // 4: cleaner.Team
-	(*GetTeamsStatsOut)(nil),     // 5: cleaner.GetTeamsStatsOut
-	(*emptypb.Empty)(nil),        // 6: google.protobuf.Empty
+	(RequestStatus)(0),               // 0: cleaner.RequestStatus
+	(ActivityKind)(0),                // 1: cleaner.ActivityKind
+	(*Request)(nil),                  // 2: cleaner.Request
+	(*Location)(nil),                 // 3: cleaner.Location
+	(*StageTime)(nil),                // 4: cleaner.StageTime
+	(*ProceedCleaningIn)(nil),        // 5: cleaner.ProceedCleaningIn
+	(*ProceedCleaningOut)(nil),       // 6: cleaner.ProceedCleaningOut
+	(*SubmitCleaningIn)(nil),         // 7: cleaner.SubmitCleaningIn
+	(*SubmitCleaningOut)(nil),        // 8: cleaner.SubmitCleaningOut
+	(*ScheduleCleaningIn)(nil),       // 9: cleaner.ScheduleCleaningIn
+	(*ScheduleCleaningOut)(nil),      // 10: cleaner.ScheduleCleaningOut
+	(*GetFreeSlotsIn)(nil),           // 11: cleaner.GetFreeSlotsIn
+	(*GetFreeSlotsOut)(nil),          // 12: cleaner.GetFreeSlotsOut
+	(*FreeSlot)(nil),                 // 13: cleaner.FreeSlot
+	(*Subscription)(nil),             // 14: cleaner.Subscription
+	(*Occurrence)(nil),               // 15: cleaner.Occurrence
+	(*CreateSubscriptionIn)(nil),     // 16: cleaner.CreateSubscriptionIn
+	(*CreateSubscriptionOut)(nil),    // 17: cleaner.CreateSubscriptionOut
+	(*GetSubscriptionIn)(nil),        // 18: cleaner.GetSubscriptionIn
+	(*GetSubscriptionOut)(nil),       // 19: cleaner.GetSubscriptionOut
+	(*CancelSubscriptionIn)(nil),     // 20: cleaner.CancelSubscriptionIn
+	(*CancelSubscriptionOut)(nil),    // 21: cleaner.CancelSubscriptionOut
+	(*SkipOccurrenceIn)(nil),         // 22: cleaner.SkipOccurrenceIn
+	(*SkipOccurrenceOut)(nil),        // 23: cleaner.SkipOccurrenceOut
+	(*RescheduleOccurrenceIn)(nil),   // 24: cleaner.RescheduleOccurrenceIn
+	(*RescheduleOccurrenceOut)(nil),  // 25: cleaner.RescheduleOccurrenceOut
+	(*GetRequestIn)(nil),             // 26: cleaner.GetRequestIn
+	(*GetRequestOut)(nil),            // 27: cleaner.GetRequestOut
+	(*Completion)(nil),               // 28: cleaner.Completion
+	(*StreamEventsIn)(nil),           // 29: cleaner.StreamEventsIn
+	(*Event)(nil),                    // 30: cleaner.Event
+	(*GetTimelineIn)(nil),            // 31: cleaner.GetTimelineIn
+	(*GetTimelineOut)(nil),           // 32: cleaner.GetTimelineOut
+	(*Interval)(nil),                 // 33: cleaner.Interval
+	(*GetAvailableTeamsIn)(nil),      // 34: cleaner.GetAvailableTeamsIn
+	(*GetAvailableTeamsOut)(nil),     // 35: cleaner.GetAvailableTeamsOut
+	(*Team)(nil),                     // 36: cleaner.Team
+	(*GetTeamsStatsOut)(nil),         // 37: cleaner.GetTeamsStatsOut
+	(*GetClientStatsIn)(nil),         // 38: cleaner.GetClientStatsIn
+	(*GetClientStatsOut)(nil),        // 39: cleaner.GetClientStatsOut
+	(*ClientStats)(nil),              // 40: cleaner.ClientStats
+	(*GetSystemStatsOut)(nil),        // 41: cleaner.GetSystemStatsOut
+	(*StageStats)(nil),               // 42: cleaner.StageStats
+	(*GetTheoreticalMetricsIn)(nil),  // 43: cleaner.GetTheoreticalMetricsIn
+	(*TheoreticalModel)(nil),         // 44: cleaner.TheoreticalModel
+	(*GetTheoreticalMetricsOut)(nil), // 45: cleaner.GetTheoreticalMetricsOut
+	nil,                              // 46: cleaner.Team.SkillsEntry
+	(*timestamppb.Timestamp)(nil),    // 47: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 48: google.protobuf.Empty
 }
 var file_cleaner_proto_depIdxs = []int32{
-	0, // 0: cleaner.ProceedCleaningIn.req:type_name -> cleaner.Request
-	0, // 1: cleaner.ProceedCleaningOut.req:type_name -> cleaner.Request
-	4, // 2: cleaner.GetTeamsStatsOut.teams:type_name -> cleaner.Team
-	1, // 3: cleaner.CleanerService.ProceedCleaning:input_type -> cleaner.ProceedCleaningIn
-	6, // 4: cleaner.CleanerService.GetAvailableTeams:input_type -> google.protobuf.Empty
-	6, // 5: cleaner.CleanerService.GetTeamsStats:input_type -> google.protobuf.Empty
-	2, // 6: cleaner.CleanerService.ProceedCleaning:output_type -> cleaner.ProceedCleaningOut
-	3, // 7: cleaner.CleanerService.GetAvailableTeams:output_type -> cleaner.GetAvailableTeamsOut
-	5, // 8: cleaner.CleanerService.GetTeamsStats:output_type -> cleaner.GetTeamsStatsOut
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	47, // 0: cleaner.Request.deadline:type_name -> google.protobuf.Timestamp
+	0,  // 1: cleaner.Request.status:type_name -> cleaner.RequestStatus
+	47, // 2: cleaner.Request.submitted_at:type_name -> google.protobuf.Timestamp
+	47, // 3: cleaner.Request.finished_at:type_name -> google.protobuf.Timestamp
+	4,  // 4: cleaner.Request.stages:type_name -> cleaner.StageTime
+	3,  // 5: cleaner.Request.location:type_name -> cleaner.Location
+	47, // 6: cleaner.Request.scheduled_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: cleaner.ProceedCleaningIn.req:type_name -> cleaner.Request
+	2,  // 8: cleaner.ProceedCleaningOut.req:type_name -> cleaner.Request
+	2,  // 9: cleaner.SubmitCleaningIn.req:type_name -> cleaner.Request
+	2,  // 10: cleaner.SubmitCleaningOut.req:type_name -> cleaner.Request
+	2,  // 11: cleaner.ScheduleCleaningIn.req:type_name -> cleaner.Request
+	47, // 12: cleaner.ScheduleCleaningIn.earliest_start:type_name -> google.protobuf.Timestamp
+	47, // 13: cleaner.ScheduleCleaningIn.latest_start:type_name -> google.protobuf.Timestamp
+	2,  // 14: cleaner.ScheduleCleaningOut.req:type_name -> cleaner.Request
+	47, // 15: cleaner.GetFreeSlotsIn.from:type_name -> google.protobuf.Timestamp
+	47, // 16: cleaner.GetFreeSlotsIn.to:type_name -> google.protobuf.Timestamp
+	13, // 17: cleaner.GetFreeSlotsOut.slots:type_name -> cleaner.FreeSlot
+	47, // 18: cleaner.FreeSlot.start:type_name -> google.protobuf.Timestamp
+	47, // 19: cleaner.FreeSlot.end:type_name -> google.protobuf.Timestamp
+	47, // 20: cleaner.Subscription.start:type_name -> google.protobuf.Timestamp
+	3,  // 21: cleaner.Subscription.location:type_name -> cleaner.Location
+	15, // 22: cleaner.Subscription.occurrences:type_name -> cleaner.Occurrence
+	47, // 23: cleaner.Occurrence.start:type_name -> google.protobuf.Timestamp
+	2,  // 24: cleaner.Occurrence.req:type_name -> cleaner.Request
+	14, // 25: cleaner.CreateSubscriptionIn.subscription:type_name -> cleaner.Subscription
+	14, // 26: cleaner.CreateSubscriptionOut.subscription:type_name -> cleaner.Subscription
+	14, // 27: cleaner.GetSubscriptionOut.subscription:type_name -> cleaner.Subscription
+	14, // 28: cleaner.CancelSubscriptionOut.subscription:type_name -> cleaner.Subscription
+	15, // 29: cleaner.SkipOccurrenceOut.occurrence:type_name -> cleaner.Occurrence
+	47, // 30: cleaner.RescheduleOccurrenceIn.start:type_name -> google.protobuf.Timestamp
+	15, // 31: cleaner.RescheduleOccurrenceOut.occurrence:type_name -> cleaner.Occurrence
+	2,  // 32: cleaner.GetRequestOut.req:type_name -> cleaner.Request
+	2,  // 33: cleaner.Completion.req:type_name -> cleaner.Request
+	47, // 34: cleaner.Completion.time:type_name -> google.protobuf.Timestamp
+	47, // 35: cleaner.Event.time:type_name -> google.protobuf.Timestamp
+	2,  // 36: cleaner.Event.request:type_name -> cleaner.Request
+	47, // 37: cleaner.GetTimelineIn.from:type_name -> google.protobuf.Timestamp
+	47, // 38: cleaner.GetTimelineIn.to:type_name -> google.protobuf.Timestamp
+	33, // 39: cleaner.GetTimelineOut.intervals:type_name -> cleaner.Interval
+	1,  // 40: cleaner.Interval.kind:type_name -> cleaner.ActivityKind
+	47, // 41: cleaner.Interval.start:type_name -> google.protobuf.Timestamp
+	47, // 42: cleaner.Interval.end:type_name -> google.protobuf.Timestamp
+	46, // 43: cleaner.Team.skills:type_name -> cleaner.Team.SkillsEntry
+	3,  // 44: cleaner.Team.position:type_name -> cleaner.Location
+	36, // 45: cleaner.GetTeamsStatsOut.teams:type_name -> cleaner.Team
+	40, // 46: cleaner.GetClientStatsOut.clients:type_name -> cleaner.ClientStats
+	42, // 47: cleaner.GetSystemStatsOut.stages:type_name -> cleaner.StageStats
+	44, // 48: cleaner.GetTheoreticalMetricsOut.models:type_name -> cleaner.TheoreticalModel
+	5,  // 49: cleaner.CleanerService.ProceedCleaning:input_type -> cleaner.ProceedCleaningIn
+	7,  // 50: cleaner.CleanerService.SubmitCleaning:input_type -> cleaner.SubmitCleaningIn
+	9,  // 51: cleaner.CleanerService.ScheduleCleaning:input_type -> cleaner.ScheduleCleaningIn
+	11, // 52: cleaner.CleanerService.GetFreeSlots:input_type -> cleaner.GetFreeSlotsIn
+	16, // 53: cleaner.CleanerService.CreateSubscription:input_type -> cleaner.CreateSubscriptionIn
+	18, // 54: cleaner.CleanerService.GetSubscription:input_type -> cleaner.GetSubscriptionIn
+	20, // 55: cleaner.CleanerService.CancelSubscription:input_type -> cleaner.CancelSubscriptionIn
+	22, // 56: cleaner.CleanerService.SkipOccurrence:input_type -> cleaner.SkipOccurrenceIn
+	24, // 57: cleaner.CleanerService.RescheduleOccurrence:input_type -> cleaner.RescheduleOccurrenceIn
+	26, // 58: cleaner.CleanerService.GetRequest:input_type -> cleaner.GetRequestIn
+	48, // 59: cleaner.CleanerService.StreamCompletions:input_type -> google.protobuf.Empty
+	29, // 60: cleaner.CleanerService.StreamEvents:input_type -> cleaner.StreamEventsIn
+	31, // 61: cleaner.CleanerService.GetTimeline:input_type -> cleaner.GetTimelineIn
+	34, // 62: cleaner.CleanerService.GetAvailableTeams:input_type -> cleaner.GetAvailableTeamsIn
+	48, // 63: cleaner.CleanerService.GetTeamsStats:input_type -> google.protobuf.Empty
+	38, // 64: cleaner.CleanerService.GetClientStats:input_type -> cleaner.GetClientStatsIn
+	48, // 65: cleaner.CleanerService.GetSystemStats:input_type -> google.protobuf.Empty
+	43, // 66: cleaner.CleanerService.GetTheoreticalMetrics:input_type -> cleaner.GetTheoreticalMetricsIn
+	6,  // 67: cleaner.CleanerService.ProceedCleaning:output_type -> cleaner.ProceedCleaningOut
+	8,  // 68: cleaner.CleanerService.SubmitCleaning:output_type -> cleaner.SubmitCleaningOut
+	10, // 69: cleaner.CleanerService.ScheduleCleaning:output_type -> cleaner.ScheduleCleaningOut
+	12, // 70: cleaner.CleanerService.GetFreeSlots:output_type -> cleaner.GetFreeSlotsOut
+	17, // 71: cleaner.CleanerService.CreateSubscription:output_type -> cleaner.CreateSubscriptionOut
+	19, // 72: cleaner.CleanerService.GetSubscription:output_type -> cleaner.GetSubscriptionOut
+	21, // 73: cleaner.CleanerService.CancelSubscription:output_type -> cleaner.CancelSubscriptionOut
+	23, // 74: cleaner.CleanerService.SkipOccurrence:output_type -> cleaner.SkipOccurrenceOut
+	25, // 75: cleaner.CleanerService.RescheduleOccurrence:output_type -> cleaner.RescheduleOccurrenceOut
+	27, // 76: cleaner.CleanerService.GetRequest:output_type -> cleaner.GetRequestOut
+	28, // 77: cleaner.CleanerService.StreamCompletions:output_type -> cleaner.Completion
+	30, // 78: cleaner.CleanerService.StreamEvents:output_type -> cleaner.Event
+	32, // 79: cleaner.CleanerService.GetTimeline:output_type -> cleaner.GetTimelineOut
+	35, // 80: cleaner.CleanerService.GetAvailableTeams:output_type -> cleaner.GetAvailableTeamsOut
+	37, // 81: cleaner.CleanerService.GetTeamsStats:output_type -> cleaner.GetTeamsStatsOut
+	39, // 82: cleaner.CleanerService.GetClientStats:output_type -> cleaner.GetClientStatsOut
+	41, // 83: cleaner.CleanerService.GetSystemStats:output_type -> cleaner.GetSystemStatsOut
+	45, // 84: cleaner.CleanerService.GetTheoreticalMetrics:output_type -> cleaner.GetTheoreticalMetricsOut
+	67, // [67:85] is the sub-list for method output_type
+	49, // [49:67] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_cleaner_proto_init() }
@@ -497,8 +4188,176 @@ func file_cleaner_proto_init() {
 				return nil
 			}
 		}
-		file_cleaner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProceedCleaningIn); i {
+		file_cleaner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProceedCleaningIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProceedCleaningOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitCleaningIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitCleaningOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleCleaningIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleCleaningOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFreeSlotsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFreeSlotsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreeSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Occurrence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscriptionIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscriptionOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -509,8 +4368,8 @@ func file_cleaner_proto_init() {
 				return nil
 			}
 		}
-		file_cleaner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProceedCleaningOut); i {
+		file_cleaner_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -521,7 +4380,199 @@ func file_cleaner_proto_init() {
 				return nil
 			}
 		}
-		file_cleaner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_cleaner_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSubscriptionIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSubscriptionOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkipOccurrenceIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkipOccurrenceOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleOccurrenceIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleOccurrenceOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequestIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequestOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Completion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEventsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimelineIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimelineOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailableTeamsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAvailableTeamsOut); i {
 			case 0:
 				return &v.state
@@ -533,7 +4584,7 @@ func file_cleaner_proto_init() {
 				return nil
 			}
 		}
-		file_cleaner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_cleaner_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Team); i {
 			case 0:
 				return &v.state
@@ -545,7 +4596,7 @@ func file_cleaner_proto_init() {
 				return nil
 			}
 		}
-		file_cleaner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_cleaner_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTeamsStatsOut); i {
 			case 0:
 				return &v.state
@@ -557,20 +4608,123 @@ func file_cleaner_proto_init() {
 				return nil
 			}
 		}
+		file_cleaner_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClientStatsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClientStatsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemStatsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTheoreticalMetricsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TheoreticalModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cleaner_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTheoreticalMetricsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_cleaner_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_cleaner_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_cleaner_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_cleaner_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_cleaner_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_cleaner_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_cleaner_proto_msgTypes[41].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cleaner_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_cleaner_proto_goTypes,
 		DependencyIndexes: file_cleaner_proto_depIdxs,
+		EnumInfos:         file_cleaner_proto_enumTypes,
 		MessageInfos:      file_cleaner_proto_msgTypes,
 	}.Build()
 	File_cleaner_proto = out.File
@@ -20,9 +20,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CleanerService_ProceedCleaning_FullMethodName   = "/cleaner.CleanerService/ProceedCleaning"
-	CleanerService_GetAvailableTeams_FullMethodName = "/cleaner.CleanerService/GetAvailableTeams"
-	CleanerService_GetTeamsStats_FullMethodName     = "/cleaner.CleanerService/GetTeamsStats"
+	CleanerService_ProceedCleaning_FullMethodName       = "/cleaner.CleanerService/ProceedCleaning"
+	CleanerService_SubmitCleaning_FullMethodName        = "/cleaner.CleanerService/SubmitCleaning"
+	CleanerService_ScheduleCleaning_FullMethodName      = "/cleaner.CleanerService/ScheduleCleaning"
+	CleanerService_GetFreeSlots_FullMethodName          = "/cleaner.CleanerService/GetFreeSlots"
+	CleanerService_CreateSubscription_FullMethodName    = "/cleaner.CleanerService/CreateSubscription"
+	CleanerService_GetSubscription_FullMethodName       = "/cleaner.CleanerService/GetSubscription"
+	CleanerService_CancelSubscription_FullMethodName    = "/cleaner.CleanerService/CancelSubscription"
+	CleanerService_SkipOccurrence_FullMethodName        = "/cleaner.CleanerService/SkipOccurrence"
+	CleanerService_RescheduleOccurrence_FullMethodName  = "/cleaner.CleanerService/RescheduleOccurrence"
+	CleanerService_GetRequest_FullMethodName            = "/cleaner.CleanerService/GetRequest"
+	CleanerService_StreamCompletions_FullMethodName     = "/cleaner.CleanerService/StreamCompletions"
+	CleanerService_StreamEvents_FullMethodName          = "/cleaner.CleanerService/StreamEvents"
+	CleanerService_GetTimeline_FullMethodName           = "/cleaner.CleanerService/GetTimeline"
+	CleanerService_GetAvailableTeams_FullMethodName     = "/cleaner.CleanerService/GetAvailableTeams"
+	CleanerService_GetTeamsStats_FullMethodName         = "/cleaner.CleanerService/GetTeamsStats"
+	CleanerService_GetClientStats_FullMethodName        = "/cleaner.CleanerService/GetClientStats"
+	CleanerService_GetSystemStats_FullMethodName        = "/cleaner.CleanerService/GetSystemStats"
+	CleanerService_GetTheoreticalMetrics_FullMethodName = "/cleaner.CleanerService/GetTheoreticalMetrics"
 )
 
 // CleanerServiceClient is the client API for CleanerService service.
@@ -30,8 +45,23 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CleanerServiceClient interface {
 	ProceedCleaning(ctx context.Context, in *ProceedCleaningIn, opts ...grpc.CallOption) (*ProceedCleaningOut, error)
-	GetAvailableTeams(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAvailableTeamsOut, error)
+	SubmitCleaning(ctx context.Context, in *SubmitCleaningIn, opts ...grpc.CallOption) (*SubmitCleaningOut, error)
+	ScheduleCleaning(ctx context.Context, in *ScheduleCleaningIn, opts ...grpc.CallOption) (*ScheduleCleaningOut, error)
+	GetFreeSlots(ctx context.Context, in *GetFreeSlotsIn, opts ...grpc.CallOption) (*GetFreeSlotsOut, error)
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionIn, opts ...grpc.CallOption) (*CreateSubscriptionOut, error)
+	GetSubscription(ctx context.Context, in *GetSubscriptionIn, opts ...grpc.CallOption) (*GetSubscriptionOut, error)
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionIn, opts ...grpc.CallOption) (*CancelSubscriptionOut, error)
+	SkipOccurrence(ctx context.Context, in *SkipOccurrenceIn, opts ...grpc.CallOption) (*SkipOccurrenceOut, error)
+	RescheduleOccurrence(ctx context.Context, in *RescheduleOccurrenceIn, opts ...grpc.CallOption) (*RescheduleOccurrenceOut, error)
+	GetRequest(ctx context.Context, in *GetRequestIn, opts ...grpc.CallOption) (*GetRequestOut, error)
+	StreamCompletions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (CleanerService_StreamCompletionsClient, error)
+	StreamEvents(ctx context.Context, in *StreamEventsIn, opts ...grpc.CallOption) (CleanerService_StreamEventsClient, error)
+	GetTimeline(ctx context.Context, in *GetTimelineIn, opts ...grpc.CallOption) (*GetTimelineOut, error)
+	GetAvailableTeams(ctx context.Context, in *GetAvailableTeamsIn, opts ...grpc.CallOption) (*GetAvailableTeamsOut, error)
 	GetTeamsStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetTeamsStatsOut, error)
+	GetClientStats(ctx context.Context, in *GetClientStatsIn, opts ...grpc.CallOption) (*GetClientStatsOut, error)
+	GetSystemStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSystemStatsOut, error)
+	GetTheoreticalMetrics(ctx context.Context, in *GetTheoreticalMetricsIn, opts ...grpc.CallOption) (*GetTheoreticalMetricsOut, error)
 }
 
 type cleanerServiceClient struct {
@@ -51,7 +81,161 @@ func (c *cleanerServiceClient) ProceedCleaning(ctx context.Context, in *ProceedC
 	return out, nil
 }
 
-func (c *cleanerServiceClient) GetAvailableTeams(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAvailableTeamsOut, error) {
+func (c *cleanerServiceClient) SubmitCleaning(ctx context.Context, in *SubmitCleaningIn, opts ...grpc.CallOption) (*SubmitCleaningOut, error) {
+	out := new(SubmitCleaningOut)
+	err := c.cc.Invoke(ctx, CleanerService_SubmitCleaning_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) ScheduleCleaning(ctx context.Context, in *ScheduleCleaningIn, opts ...grpc.CallOption) (*ScheduleCleaningOut, error) {
+	out := new(ScheduleCleaningOut)
+	err := c.cc.Invoke(ctx, CleanerService_ScheduleCleaning_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) GetFreeSlots(ctx context.Context, in *GetFreeSlotsIn, opts ...grpc.CallOption) (*GetFreeSlotsOut, error) {
+	out := new(GetFreeSlotsOut)
+	err := c.cc.Invoke(ctx, CleanerService_GetFreeSlots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionIn, opts ...grpc.CallOption) (*CreateSubscriptionOut, error) {
+	out := new(CreateSubscriptionOut)
+	err := c.cc.Invoke(ctx, CleanerService_CreateSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) GetSubscription(ctx context.Context, in *GetSubscriptionIn, opts ...grpc.CallOption) (*GetSubscriptionOut, error) {
+	out := new(GetSubscriptionOut)
+	err := c.cc.Invoke(ctx, CleanerService_GetSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionIn, opts ...grpc.CallOption) (*CancelSubscriptionOut, error) {
+	out := new(CancelSubscriptionOut)
+	err := c.cc.Invoke(ctx, CleanerService_CancelSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) SkipOccurrence(ctx context.Context, in *SkipOccurrenceIn, opts ...grpc.CallOption) (*SkipOccurrenceOut, error) {
+	out := new(SkipOccurrenceOut)
+	err := c.cc.Invoke(ctx, CleanerService_SkipOccurrence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) RescheduleOccurrence(ctx context.Context, in *RescheduleOccurrenceIn, opts ...grpc.CallOption) (*RescheduleOccurrenceOut, error) {
+	out := new(RescheduleOccurrenceOut)
+	err := c.cc.Invoke(ctx, CleanerService_RescheduleOccurrence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) GetRequest(ctx context.Context, in *GetRequestIn, opts ...grpc.CallOption) (*GetRequestOut, error) {
+	out := new(GetRequestOut)
+	err := c.cc.Invoke(ctx, CleanerService_GetRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) StreamCompletions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (CleanerService_StreamCompletionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CleanerService_ServiceDesc.Streams[0], CleanerService_StreamCompletions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cleanerServiceStreamCompletionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CleanerService_StreamCompletionsClient interface {
+	Recv() (*Completion, error)
+	grpc.ClientStream
+}
+
+type cleanerServiceStreamCompletionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cleanerServiceStreamCompletionsClient) Recv() (*Completion, error) {
+	m := new(Completion)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cleanerServiceClient) StreamEvents(ctx context.Context, in *StreamEventsIn, opts ...grpc.CallOption) (CleanerService_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CleanerService_ServiceDesc.Streams[1], CleanerService_StreamEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cleanerServiceStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CleanerService_StreamEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type cleanerServiceStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cleanerServiceStreamEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cleanerServiceClient) GetTimeline(ctx context.Context, in *GetTimelineIn, opts ...grpc.CallOption) (*GetTimelineOut, error) {
+	out := new(GetTimelineOut)
+	err := c.cc.Invoke(ctx, CleanerService_GetTimeline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) GetAvailableTeams(ctx context.Context, in *GetAvailableTeamsIn, opts ...grpc.CallOption) (*GetAvailableTeamsOut, error) {
 	out := new(GetAvailableTeamsOut)
 	err := c.cc.Invoke(ctx, CleanerService_GetAvailableTeams_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -69,13 +253,55 @@ func (c *cleanerServiceClient) GetTeamsStats(ctx context.Context, in *emptypb.Em
 	return out, nil
 }
 
+func (c *cleanerServiceClient) GetClientStats(ctx context.Context, in *GetClientStatsIn, opts ...grpc.CallOption) (*GetClientStatsOut, error) {
+	out := new(GetClientStatsOut)
+	err := c.cc.Invoke(ctx, CleanerService_GetClientStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) GetSystemStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSystemStatsOut, error) {
+	out := new(GetSystemStatsOut)
+	err := c.cc.Invoke(ctx, CleanerService_GetSystemStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cleanerServiceClient) GetTheoreticalMetrics(ctx context.Context, in *GetTheoreticalMetricsIn, opts ...grpc.CallOption) (*GetTheoreticalMetricsOut, error) {
+	out := new(GetTheoreticalMetricsOut)
+	err := c.cc.Invoke(ctx, CleanerService_GetTheoreticalMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CleanerServiceServer is the server API for CleanerService service.
 // All implementations must embed UnimplementedCleanerServiceServer
 // for forward compatibility
 type CleanerServiceServer interface {
 	ProceedCleaning(context.Context, *ProceedCleaningIn) (*ProceedCleaningOut, error)
-	GetAvailableTeams(context.Context, *emptypb.Empty) (*GetAvailableTeamsOut, error)
+	SubmitCleaning(context.Context, *SubmitCleaningIn) (*SubmitCleaningOut, error)
+	ScheduleCleaning(context.Context, *ScheduleCleaningIn) (*ScheduleCleaningOut, error)
+	GetFreeSlots(context.Context, *GetFreeSlotsIn) (*GetFreeSlotsOut, error)
+	CreateSubscription(context.Context, *CreateSubscriptionIn) (*CreateSubscriptionOut, error)
+	GetSubscription(context.Context, *GetSubscriptionIn) (*GetSubscriptionOut, error)
+	CancelSubscription(context.Context, *CancelSubscriptionIn) (*CancelSubscriptionOut, error)
+	SkipOccurrence(context.Context, *SkipOccurrenceIn) (*SkipOccurrenceOut, error)
+	RescheduleOccurrence(context.Context, *RescheduleOccurrenceIn) (*RescheduleOccurrenceOut, error)
+	GetRequest(context.Context, *GetRequestIn) (*GetRequestOut, error)
+	StreamCompletions(*emptypb.Empty, CleanerService_StreamCompletionsServer) error
+	StreamEvents(*StreamEventsIn, CleanerService_StreamEventsServer) error
+	GetTimeline(context.Context, *GetTimelineIn) (*GetTimelineOut, error)
+	GetAvailableTeams(context.Context, *GetAvailableTeamsIn) (*GetAvailableTeamsOut, error)
 	GetTeamsStats(context.Context, *emptypb.Empty) (*GetTeamsStatsOut, error)
+	GetClientStats(context.Context, *GetClientStatsIn) (*GetClientStatsOut, error)
+	GetSystemStats(context.Context, *emptypb.Empty) (*GetSystemStatsOut, error)
+	GetTheoreticalMetrics(context.Context, *GetTheoreticalMetricsIn) (*GetTheoreticalMetricsOut, error)
 	mustEmbedUnimplementedCleanerServiceServer()
 }
 
@@ -86,12 +312,57 @@ type UnimplementedCleanerServiceServer struct {
 func (UnimplementedCleanerServiceServer) ProceedCleaning(context.Context, *ProceedCleaningIn) (*ProceedCleaningOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProceedCleaning not implemented")
 }
-func (UnimplementedCleanerServiceServer) GetAvailableTeams(context.Context, *emptypb.Empty) (*GetAvailableTeamsOut, error) {
+func (UnimplementedCleanerServiceServer) SubmitCleaning(context.Context, *SubmitCleaningIn) (*SubmitCleaningOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCleaning not implemented")
+}
+func (UnimplementedCleanerServiceServer) ScheduleCleaning(context.Context, *ScheduleCleaningIn) (*ScheduleCleaningOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCleaning not implemented")
+}
+func (UnimplementedCleanerServiceServer) GetFreeSlots(context.Context, *GetFreeSlotsIn) (*GetFreeSlotsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreeSlots not implemented")
+}
+func (UnimplementedCleanerServiceServer) CreateSubscription(context.Context, *CreateSubscriptionIn) (*CreateSubscriptionOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedCleanerServiceServer) GetSubscription(context.Context, *GetSubscriptionIn) (*GetSubscriptionOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (UnimplementedCleanerServiceServer) CancelSubscription(context.Context, *CancelSubscriptionIn) (*CancelSubscriptionOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedCleanerServiceServer) SkipOccurrence(context.Context, *SkipOccurrenceIn) (*SkipOccurrenceOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipOccurrence not implemented")
+}
+func (UnimplementedCleanerServiceServer) RescheduleOccurrence(context.Context, *RescheduleOccurrenceIn) (*RescheduleOccurrenceOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleOccurrence not implemented")
+}
+func (UnimplementedCleanerServiceServer) GetRequest(context.Context, *GetRequestIn) (*GetRequestOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRequest not implemented")
+}
+func (UnimplementedCleanerServiceServer) StreamCompletions(*emptypb.Empty, CleanerService_StreamCompletionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCompletions not implemented")
+}
+func (UnimplementedCleanerServiceServer) StreamEvents(*StreamEventsIn, CleanerService_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedCleanerServiceServer) GetTimeline(context.Context, *GetTimelineIn) (*GetTimelineOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeline not implemented")
+}
+func (UnimplementedCleanerServiceServer) GetAvailableTeams(context.Context, *GetAvailableTeamsIn) (*GetAvailableTeamsOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableTeams not implemented")
 }
 func (UnimplementedCleanerServiceServer) GetTeamsStats(context.Context, *emptypb.Empty) (*GetTeamsStatsOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTeamsStats not implemented")
 }
+func (UnimplementedCleanerServiceServer) GetClientStats(context.Context, *GetClientStatsIn) (*GetClientStatsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientStats not implemented")
+}
+func (UnimplementedCleanerServiceServer) GetSystemStats(context.Context, *emptypb.Empty) (*GetSystemStatsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemStats not implemented")
+}
+func (UnimplementedCleanerServiceServer) GetTheoreticalMetrics(context.Context, *GetTheoreticalMetricsIn) (*GetTheoreticalMetricsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTheoreticalMetrics not implemented")
+}
 func (UnimplementedCleanerServiceServer) mustEmbedUnimplementedCleanerServiceServer() {}
 
 // UnsafeCleanerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -123,8 +394,230 @@ func _CleanerService_ProceedCleaning_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CleanerService_SubmitCleaning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitCleaningIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).SubmitCleaning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_SubmitCleaning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).SubmitCleaning(ctx, req.(*SubmitCleaningIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_ScheduleCleaning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleCleaningIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).ScheduleCleaning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_ScheduleCleaning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).ScheduleCleaning(ctx, req.(*ScheduleCleaningIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_GetFreeSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFreeSlotsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).GetFreeSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_GetFreeSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).GetFreeSlots(ctx, req.(*GetFreeSlotsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).CreateSubscription(ctx, req.(*CreateSubscriptionIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_GetSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).GetSubscription(ctx, req.(*GetSubscriptionIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSubscriptionIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).CancelSubscription(ctx, req.(*CancelSubscriptionIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_SkipOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipOccurrenceIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).SkipOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_SkipOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).SkipOccurrence(ctx, req.(*SkipOccurrenceIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_RescheduleOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleOccurrenceIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).RescheduleOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_RescheduleOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).RescheduleOccurrence(ctx, req.(*RescheduleOccurrenceIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_GetRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequestIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).GetRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_GetRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).GetRequest(ctx, req.(*GetRequestIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_StreamCompletions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CleanerServiceServer).StreamCompletions(m, &cleanerServiceStreamCompletionsServer{stream})
+}
+
+type CleanerService_StreamCompletionsServer interface {
+	Send(*Completion) error
+	grpc.ServerStream
+}
+
+type cleanerServiceStreamCompletionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cleanerServiceStreamCompletionsServer) Send(m *Completion) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CleanerService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsIn)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CleanerServiceServer).StreamEvents(m, &cleanerServiceStreamEventsServer{stream})
+}
+
+type CleanerService_StreamEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type cleanerServiceStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cleanerServiceStreamEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CleanerService_GetTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimelineIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).GetTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_GetTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).GetTimeline(ctx, req.(*GetTimelineIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CleanerService_GetAvailableTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetAvailableTeamsIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -136,7 +629,7 @@ func _CleanerService_GetAvailableTeams_Handler(srv interface{}, ctx context.Cont
 		FullMethod: CleanerService_GetAvailableTeams_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CleanerServiceServer).GetAvailableTeams(ctx, req.(*emptypb.Empty))
+		return srv.(CleanerServiceServer).GetAvailableTeams(ctx, req.(*GetAvailableTeamsIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -159,6 +652,60 @@ func _CleanerService_GetTeamsStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CleanerService_GetClientStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientStatsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).GetClientStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_GetClientStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).GetClientStats(ctx, req.(*GetClientStatsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_GetSystemStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).GetSystemStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_GetSystemStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).GetSystemStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CleanerService_GetTheoreticalMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTheoreticalMetricsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CleanerServiceServer).GetTheoreticalMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CleanerService_GetTheoreticalMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CleanerServiceServer).GetTheoreticalMetrics(ctx, req.(*GetTheoreticalMetricsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CleanerService_ServiceDesc is the grpc.ServiceDesc for CleanerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -170,6 +717,46 @@ var CleanerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProceedCleaning",
 			Handler:    _CleanerService_ProceedCleaning_Handler,
 		},
+		{
+			MethodName: "SubmitCleaning",
+			Handler:    _CleanerService_SubmitCleaning_Handler,
+		},
+		{
+			MethodName: "ScheduleCleaning",
+			Handler:    _CleanerService_ScheduleCleaning_Handler,
+		},
+		{
+			MethodName: "GetFreeSlots",
+			Handler:    _CleanerService_GetFreeSlots_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _CleanerService_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "GetSubscription",
+			Handler:    _CleanerService_GetSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _CleanerService_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "SkipOccurrence",
+			Handler:    _CleanerService_SkipOccurrence_Handler,
+		},
+		{
+			MethodName: "RescheduleOccurrence",
+			Handler:    _CleanerService_RescheduleOccurrence_Handler,
+		},
+		{
+			MethodName: "GetRequest",
+			Handler:    _CleanerService_GetRequest_Handler,
+		},
+		{
+			MethodName: "GetTimeline",
+			Handler:    _CleanerService_GetTimeline_Handler,
+		},
 		{
 			MethodName: "GetAvailableTeams",
 			Handler:    _CleanerService_GetAvailableTeams_Handler,
@@ -178,7 +765,30 @@ var CleanerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTeamsStats",
 			Handler:    _CleanerService_GetTeamsStats_Handler,
 		},
+		{
+			MethodName: "GetClientStats",
+			Handler:    _CleanerService_GetClientStats_Handler,
+		},
+		{
+			MethodName: "GetSystemStats",
+			Handler:    _CleanerService_GetSystemStats_Handler,
+		},
+		{
+			MethodName: "GetTheoreticalMetrics",
+			Handler:    _CleanerService_GetTheoreticalMetrics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCompletions",
+			Handler:       _CleanerService_StreamCompletions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _CleanerService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cleaner.proto",
 }
@@ -0,0 +1,11 @@
+// Package openapi publishes OpenAPI specification of cleaner's HTTP/JSON gateway
+package openapi
+
+import (
+	_ "embed"
+)
+
+// Spec is an OpenAPI v2 specification generated from api/grpc/cleaner.proto
+//
+//go:embed cleaner.swagger.json
+var Spec []byte
@@ -0,0 +1,87 @@
+package queueing
+
+import (
+	"errors"
+)
+
+// Names of models evaluated for cleaner
+const (
+	ModelMM1  = "M/M/1"
+	ModelMMc  = "M/M/c"
+	ModelMMcK = "M/M/c/K"
+	ModelMMcc = "M/M/c/c"
+	ModelMG1  = "M/G/1"
+)
+
+// Params describe cleaner as a queueing system
+type Params struct {
+	ArrivalRate  float64   // ArrivalRate is a rate of incoming requests
+	ServiceRates []float64 // ServiceRates are rates of every team, 1 / mean cleaning time
+}
+
+// Model is a result of a single model's evaluation
+type Model struct {
+	Name    string
+	Metrics *Metrics
+	Err     error // Err is set if model can't be evaluated, e.g. it is unstable
+}
+
+// Servers returns amount of servers
+func (p *Params) Servers() int {
+	return len(p.ServiceRates)
+}
+
+// MeanServiceRate returns mean team's service rate. Teams of different speeds are approximated
+// by identical ones with the same total capacity
+func (p *Params) MeanServiceRate() float64 {
+	if len(p.ServiceRates) == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, mu := range p.ServiceRates {
+		total += mu
+	}
+
+	return total / float64(len(p.ServiceRates))
+}
+
+// Evaluate computes every model for cleaner:
+//   - M/M/1: one of c teams, which gets 1/c of arrivals, as if every team had its own queue;
+//   - M/M/c: teams share a single infinite queue, waiting probability is given by Erlang C;
+//   - M/M/c/K: teams share a queue of queueCapacity places, evaluated if queueCapacity >= 0;
+//   - M/M/c/c: pure loss system, blocking probability is given by Erlang B;
+//   - M/G/1: as M/M/1, but cleaning time has squared coefficient of variation scv.
+func (p *Params) Evaluate(queueCapacity int, scv float64) ([]*Model, error) {
+	c := p.Servers()
+	if c == 0 {
+		return nil, errors.New("system has no servers")
+	}
+
+	mu := p.MeanServiceRate()
+	lambda := p.ArrivalRate
+
+	models := make([]*Model, 0, 5)
+	add := func(name string, m *Metrics, err error) {
+		models = append(models, &Model{Name: name, Metrics: m, Err: err})
+	}
+
+	m, err := MM1(lambda/float64(c), mu)
+	add(ModelMM1, m, err)
+
+	m, err = MMc(lambda, mu, c)
+	add(ModelMMc, m, err)
+
+	if queueCapacity >= 0 {
+		m, err = MMcK(lambda, mu, c, c+queueCapacity)
+		add(ModelMMcK, m, err)
+	}
+
+	m, err = MMcK(lambda, mu, c, c)
+	add(ModelMMcc, m, err)
+
+	m, err = MG1(lambda/float64(c), 1/mu, scv)
+	add(ModelMG1, m, err)
+
+	return models, nil
+}
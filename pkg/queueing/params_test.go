@@ -0,0 +1,58 @@
+package queueing
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	p := &Params{ArrivalRate: 2, ServiceRates: []float64{0.5, 1.5, 1}}
+	if mu := p.MeanServiceRate(); !near(mu, 1) {
+		t.Fatalf("mean service rate = %.4f, want 1", mu)
+	}
+
+	models, err := p.Evaluate(-1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]float64{ // mean waiting time of every model
+		ModelMM1:  2, // a third of arrivals: rho / (mu - lambda)
+		ModelMMc:  0.4444,
+		ModelMMcc: 0,
+		ModelMG1:  2,
+	}
+	if len(models) != len(want) {
+		t.Fatalf("evaluated %d models, want %d without M/M/c/K", len(models), len(want))
+	}
+	for _, m := range models {
+		if m.Err != nil {
+			t.Fatalf("%s: %v", m.Name, m.Err)
+		}
+		if !near(m.Metrics.Wq, want[m.Name]) {
+			t.Errorf("%s: Wq = %.4f, want %.4f", m.Name, m.Metrics.Wq, want[m.Name])
+		}
+	}
+}
+
+func TestEvaluateUnstable(t *testing.T) {
+	p := &Params{ArrivalRate: 4, ServiceRates: []float64{1, 1, 1}}
+	models, err := p.Evaluate(2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, m := range models {
+		unstable := errors.Is(m.Err, ErrUnstable)
+		finite := m.Name == ModelMMcK || m.Name == ModelMMcc
+		if unstable == finite {
+			t.Errorf("%s: error = %v, only systems with infinite queue are unstable", m.Name, m.Err)
+		}
+	}
+}
+
+func TestEvaluateNoServers(t *testing.T) {
+	if _, err := (&Params{ArrivalRate: 1}).Evaluate(0, 1); err == nil {
+		t.Fatal("system without servers is evaluated")
+	}
+}
@@ -0,0 +1,174 @@
+// Package queueing computes closed-form steady-state metrics of classic queueing models:
+// M/M/1, M/M/c, M/M/c/K, Erlang B and C formulas and M/G/1 by Pollaczek–Khinchine formula.
+// Rates are per second, times are in seconds
+package queueing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrUnstable is returned when offered load exceeds capacity of a system with infinite queue
+var ErrUnstable = errors.New("system is unstable: utilization is not less than 1")
+
+// Metrics are steady-state metrics of a queueing system
+type Metrics struct {
+	Utilization         float64 // Utilization is a share of time a server is busy
+	WaitProbability     float64 // WaitProbability is a probability that accepted request waits in queue
+	BlockingProbability float64 // BlockingProbability is a probability that request is rejected
+	Lq                  float64 // Lq is a mean queue length
+	L                   float64 // L is a mean amount of requests in system
+	Wq                  float64 // Wq is a mean waiting time
+	W                   float64 // W is a mean response (sojourn) time
+	Throughput          float64 // Throughput is a rate of accepted requests
+}
+
+// MM1 computes metrics of M/M/1 system with arrival rate lambda and service rate mu
+func MM1(lambda, mu float64) (*Metrics, error) {
+	return MMc(lambda, mu, 1)
+}
+
+// MMc computes metrics of M/M/c system with c identical servers
+func MMc(lambda, mu float64, c int) (*Metrics, error) {
+	if err := checkRates(lambda, mu, c); err != nil {
+		return nil, err
+	}
+
+	a := lambda / mu
+	rho := a / float64(c)
+	if rho >= 1 {
+		return nil, ErrUnstable
+	}
+
+	pw := ErlangC(a, c)
+	lq := pw * rho / (1 - rho)
+	wq := lq / lambda
+
+	return &Metrics{
+		Utilization:     rho,
+		WaitProbability: pw,
+		Lq:              lq,
+		L:               lq + a,
+		Wq:              wq,
+		W:               wq + 1/mu,
+		Throughput:      lambda,
+	}, nil
+}
+
+// MMcK computes metrics of M/M/c/K system, which holds at most k >= c requests (in service and queued).
+// M/M/c/c is a pure loss system described by Erlang B formula
+func MMcK(lambda, mu float64, c, k int) (*Metrics, error) {
+	if err := checkRates(lambda, mu, c); err != nil {
+		return nil, err
+	}
+	if k < c {
+		return nil, fmt.Errorf("capacity %d is less than amount of servers %d", k, c)
+	}
+
+	a := lambda / mu
+
+	// Unnormalized state probabilities p_n/p_0, computed iteratively to avoid overflow of factorials
+	probs := make([]float64, k+1)
+	probs[0] = 1
+	total := 1.0
+	for n := 1; n <= k; n++ {
+		probs[n] = probs[n-1] * a / float64(min(n, c))
+		total += probs[n]
+	}
+
+	var l, lq, busy float64
+	for n, p := range probs {
+		p /= total
+		probs[n] = p
+		l += float64(n) * p
+		lq += float64(max(n-c, 0)) * p
+		busy += float64(min(n, c)) * p
+	}
+
+	pk := probs[k]
+	lambdaEff := lambda * (1 - pk)
+
+	// Probability that accepted request finds all servers busy
+	var waiting float64
+	for n := c; n < k; n++ {
+		waiting += probs[n]
+	}
+
+	m := &Metrics{
+		Utilization:         busy / float64(c),
+		BlockingProbability: pk,
+		Lq:                  lq,
+		L:                   l,
+		Throughput:          lambdaEff,
+	}
+	if pk < 1 {
+		m.WaitProbability = waiting / (1 - pk)
+	}
+	if lambdaEff > 0 {
+		m.Wq = lq / lambdaEff
+		m.W = l / lambdaEff
+	}
+
+	return m, nil
+}
+
+// ErlangB returns blocking probability of M/M/c/c loss system with offered load a = lambda/mu
+func ErlangB(a float64, c int) float64 {
+	// Recurrence B(a, n) = a*B(a, n-1) / (n + a*B(a, n-1)) is numerically stable
+	b := 1.0
+	for n := 1; n <= c; n++ {
+		b = a * b / (float64(n) + a*b)
+	}
+
+	return b
+}
+
+// ErlangC returns probability of waiting in M/M/c system with offered load a = lambda/mu.
+// Returns 1 if system is unstable
+func ErlangC(a float64, c int) float64 {
+	rho := a / float64(c)
+	if rho >= 1 {
+		return 1
+	}
+
+	b := ErlangB(a, c)
+	return b / (1 - rho*(1-b))
+}
+
+// MG1 computes metrics of M/G/1 system by Pollaczek–Khinchine formula.
+// Service time has mean meanService and squared coefficient of variation scv (1 for exponential, 0 for deterministic)
+func MG1(lambda, meanService, scv float64) (*Metrics, error) {
+	if lambda <= 0 || meanService <= 0 || scv < 0 || math.IsNaN(scv) {
+		return nil, errors.New("arrival rate and mean service time must be positive, scv must be non-negative")
+	}
+
+	rho := lambda * meanService
+	if rho >= 1 {
+		return nil, ErrUnstable
+	}
+
+	wq := rho * meanService * (1 + scv) / (2 * (1 - rho))
+	lq := lambda * wq
+
+	return &Metrics{
+		Utilization:     rho,
+		WaitProbability: rho,
+		Lq:              lq,
+		L:               lq + rho,
+		Wq:              wq,
+		W:               wq + meanService,
+		Throughput:      lambda,
+	}, nil
+}
+
+func checkRates(lambda, mu float64, c int) error {
+	if lambda <= 0 || mu <= 0 {
+		return errors.New("arrival and service rates must be positive")
+	}
+	if c < 1 {
+		return errors.New("system needs at least one server")
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package queueing
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+const tolerance = 1e-4
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func TestErlangB(t *testing.T) {
+	tests := []struct {
+		a    float64
+		c    int
+		want float64
+	}{
+		{1, 1, 0.5},
+		{2, 3, 0.2105},
+		{10, 10, 0.2146},
+		{5, 0, 1},
+		{0, 4, 0},
+	}
+
+	for _, tt := range tests {
+		if got := ErlangB(tt.a, tt.c); !near(got, tt.want) {
+			t.Errorf("ErlangB(%v, %d) = %.4f, want %.4f", tt.a, tt.c, got, tt.want)
+		}
+	}
+}
+
+func TestErlangC(t *testing.T) {
+	tests := []struct {
+		a    float64
+		c    int
+		want float64
+	}{
+		{2, 3, 0.4444},
+		{0.5, 1, 0.5}, // single server waits with probability rho
+		{8, 10, 0.4092},
+		{3, 3, 1}, // rho = 1 is unstable
+		{4, 3, 1},
+	}
+
+	for _, tt := range tests {
+		if got := ErlangC(tt.a, tt.c); !near(got, tt.want) {
+			t.Errorf("ErlangC(%v, %d) = %.4f, want %.4f", tt.a, tt.c, got, tt.want)
+		}
+	}
+}
+
+func TestMMc(t *testing.T) {
+	tests := []struct {
+		name       string
+		lambda, mu float64
+		c          int
+		want       Metrics
+	}{
+		{
+			name: "M/M/1", lambda: 1, mu: 2, c: 1,
+			want: Metrics{Utilization: 0.5, WaitProbability: 0.5, Lq: 0.5, L: 1, Wq: 0.5, W: 1, Throughput: 1},
+		},
+		{
+			name: "M/M/3", lambda: 2, mu: 1, c: 3,
+			want: Metrics{Utilization: 0.6667, WaitProbability: 0.4444, Lq: 0.8889, L: 2.8889, Wq: 0.4444, W: 1.4444, Throughput: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MMc(tt.lambda, tt.mu, tt.c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkMetrics(t, got, &tt.want)
+		})
+	}
+}
+
+func TestMMcUnstable(t *testing.T) {
+	for _, lambda := range []float64{3, 4} {
+		if _, err := MMc(lambda, 1, 3); !errors.Is(err, ErrUnstable) {
+			t.Errorf("MMc(%v, 1, 3) error = %v, want ErrUnstable", lambda, err)
+		}
+	}
+}
+
+func TestMMcK(t *testing.T) {
+	tests := []struct {
+		name       string
+		lambda, mu float64
+		c, k       int
+		want       Metrics
+	}{
+		{
+			// K = c is a loss system, blocking is given by Erlang B and nobody waits
+			name: "M/M/3/3", lambda: 2, mu: 1, c: 3, k: 3,
+			want: Metrics{Utilization: 0.5263, BlockingProbability: 0.2105, L: 1.5789, W: 1, Throughput: 1.5789},
+		},
+		{
+			name: "M/M/1/2", lambda: 1, mu: 1, c: 1, k: 2,
+			want: Metrics{Utilization: 0.6667, WaitProbability: 0.5, BlockingProbability: 0.3333, Lq: 0.3333, L: 1, Wq: 0.5, W: 1.5, Throughput: 0.6667},
+		},
+		{
+			// Finite system is stable even if offered load exceeds its capacity
+			name: "overloaded M/M/1/1", lambda: 3, mu: 1, c: 1, k: 1,
+			want: Metrics{Utilization: 0.75, BlockingProbability: 0.75, L: 0.75, W: 1, Throughput: 0.75},
+		},
+		{
+			// Large capacity approaches M/M/c
+			name: "M/M/3/200", lambda: 2, mu: 1, c: 3, k: 200,
+			want: Metrics{Utilization: 0.6667, WaitProbability: 0.4444, Lq: 0.8889, L: 2.8889, Wq: 0.4444, W: 1.4444, Throughput: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MMcK(tt.lambda, tt.mu, tt.c, tt.k)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkMetrics(t, got, &tt.want)
+		})
+	}
+}
+
+func TestMMcKCapacityLessThanServers(t *testing.T) {
+	if _, err := MMcK(1, 1, 3, 2); err == nil {
+		t.Fatal("MMcK with k < c succeeded")
+	}
+}
+
+func TestMG1(t *testing.T) {
+	tests := []struct {
+		name                     string
+		lambda, meanService, scv float64
+		want                     Metrics
+	}{
+		{
+			// Exponential service matches M/M/1
+			name: "M/M/1", lambda: 0.5, meanService: 1, scv: 1,
+			want: Metrics{Utilization: 0.5, WaitProbability: 0.5, Lq: 0.5, L: 1, Wq: 1, W: 2, Throughput: 0.5},
+		},
+		{
+			// Deterministic service halves waiting of M/M/1
+			name: "M/D/1", lambda: 0.5, meanService: 1, scv: 0,
+			want: Metrics{Utilization: 0.5, WaitProbability: 0.5, Lq: 0.25, L: 0.75, Wq: 0.5, W: 1.5, Throughput: 0.5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MG1(tt.lambda, tt.meanService, tt.scv)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkMetrics(t, got, &tt.want)
+		})
+	}
+
+	if _, err := MG1(1, 1, 1); !errors.Is(err, ErrUnstable) {
+		t.Errorf("MG1 with rho = 1 error = %v, want ErrUnstable", err)
+	}
+}
+
+func checkMetrics(t *testing.T, got, want *Metrics) {
+	t.Helper()
+
+	fields := []struct {
+		name      string
+		got, want float64
+	}{
+		{"utilization", got.Utilization, want.Utilization},
+		{"wait probability", got.WaitProbability, want.WaitProbability},
+		{"blocking probability", got.BlockingProbability, want.BlockingProbability},
+		{"Lq", got.Lq, want.Lq},
+		{"L", got.L, want.L},
+		{"Wq", got.Wq, want.Wq},
+		{"W", got.W, want.W},
+		{"throughput", got.Throughput, want.Throughput},
+	}
+	for _, f := range fields {
+		if !near(f.got, f.want) {
+			t.Errorf("%s = %.4f, want %.4f", f.name, f.got, f.want)
+		}
+	}
+}